@@ -0,0 +1,42 @@
+package gocerr
+
+// Builder accumulates error fields across many checks and produces an Error
+// at the end. Build copies the fields, so a builder can keep being used after
+// building without affecting errors built earlier.
+type Builder struct {
+	code        int
+	message     string
+	errorFields []ErrorField
+}
+
+func NewBuilder(code int, message string) *Builder {
+	return &Builder{
+		code:    code,
+		message: message,
+	}
+}
+
+func (b *Builder) AddField(field string, message string) *Builder {
+	b.errorFields = append(b.errorFields, NewErrorField(field, message))
+	return b
+}
+
+func (b *Builder) AddFieldIf(cond bool, field string, message string) *Builder {
+	if cond {
+		b.AddField(field, message)
+	}
+	return b
+}
+
+func (b *Builder) SetCode(code int) *Builder {
+	b.code = code
+	return b
+}
+
+func (b *Builder) Build() Error {
+	var errorFields []ErrorField = make([]ErrorField, len(b.errorFields))
+
+	copy(errorFields, b.errorFields)
+
+	return New(b.code, b.message, errorFields...)
+}
@@ -0,0 +1,54 @@
+package gocerr
+
+import "testing"
+
+func TestBuilder_AddFieldIf(t *testing.T) {
+	var (
+		expected Error = New(
+			422,
+			"bad request",
+			NewErrorField("name", "field is required"),
+			NewErrorField("age", "min value is 18"),
+		)
+		actual Error
+	)
+
+	actual = NewBuilder(400, "bad request").
+		AddField("name", "field is required").
+		AddFieldIf(false, "email", "email is invalid").
+		AddFieldIf(true, "age", "min value is 18").
+		SetCode(422).
+		Build()
+
+	if !expected.EqualIgnoring(actual) {
+		t.Errorf("expected error is %s, but got %s", expected.String(), actual.String())
+	}
+}
+
+func TestBuilder_Build(t *testing.T) {
+	var (
+		builder *Builder = NewBuilder(400, "bad request").AddField("name", "field is required")
+		first   Error
+		second  Error
+	)
+
+	first = builder.Build()
+	second = builder.AddField("age", "min value is 18").Build()
+
+	if len(first.ErrorFields) != 1 {
+		t.Errorf("expected length of first error fields is %d, but got %d", 1, len(first.ErrorFields))
+	}
+
+	if len(second.ErrorFields) != 2 {
+		t.Errorf("expected length of second error fields is %d, but got %d", 2, len(second.ErrorFields))
+	}
+
+	first.ErrorFields[0].Message = "changed"
+	if second.ErrorFields[0].Message != "field is required" {
+		t.Errorf("expected second error field is unchanged, but got %s", second.ErrorFields[0].Message)
+	}
+
+	if builder.Build().ErrorFields[0].Message != "field is required" {
+		t.Errorf("expected builder error field is unchanged, but got %s", builder.Build().ErrorFields[0].Message)
+	}
+}
@@ -1,9 +1,47 @@
 package gocerr
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"iter"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
 type Error struct {
 	Code        int
 	Message     string
 	ErrorFields []ErrorField
+	Cause       error
+	TraceID     string
+	Timestamp   time.Time
+	// MessageKey, when set, identifies the message for a Localizer.
+	MessageKey string
+
+	// retryable overrides the code-based retry classification when set.
+	retryable *bool
+	// userVisible overrides the code-based visibility classification when set.
+	userVisible *bool
+}
+
+// OnNew, when set, is called with every error created by New and the
+// constructors built on it. Decoding an error, as UnmarshalJSON and
+// UnmarshalBinary do, is not creation and does not call it. Install it during
+// initialization; it must not be changed while errors are being created
+// concurrently.
+var OnNew func(Error)
+
+func notifyNew(err Error) Error {
+	if OnNew != nil {
+		OnNew(err)
+	}
+
+	return err
 }
 
 func New(code int, message string, errorFields ...ErrorField) Error {
@@ -13,13 +51,192 @@ func New(code int, message string, errorFields ...ErrorField) Error {
 		ErrorFields: errorFields,
 	}
 
-	return err
+	return notifyNew(err)
+}
+
+// NewAt is like New but also records ts as the time the error occurred. New
+// leaves the timestamp zero so that creating an error never reads the clock.
+func NewAt(code int, message string, ts time.Time, errorFields ...ErrorField) Error {
+	var err Error = Error{
+		Code:        code,
+		Message:     message,
+		ErrorFields: errorFields,
+		Timestamp:   ts,
+	}
+
+	return notifyNew(err)
+}
+
+// Wrap is like New but also records cause as the underlying error, which is
+// then reachable through Unwrap, errors.Is and errors.As.
+func Wrap(code int, message string, cause error, errorFields ...ErrorField) Error {
+	var err Error = Error{
+		Code:        code,
+		Message:     message,
+		ErrorFields: errorFields,
+		Cause:       cause,
+	}
+
+	return notifyNew(err)
+}
+
+// NewF is like New but takes the error fields as F pairs, e.g.
+// NewF(422, "bad", F{"email", "invalid"}).
+func NewF(code int, message string, fields ...F) Error {
+	var errorFields []ErrorField = make([]ErrorField, 0, len(fields))
+
+	for i := 0; i < len(fields); i++ {
+		errorFields = append(errorFields, NewErrorField(fields[i].Field, fields[i].Message))
+	}
+
+	return New(code, message, errorFields...)
+}
+
+// CollectFields drains ch until it is closed, accumulating every received
+// error field into an error with the given code and message. The returned
+// bool reports whether any field arrived.
+func CollectFields(code int, message string, ch <-chan ErrorField) (Error, bool) {
+	var errorFields []ErrorField
+
+	for errorField := range ch {
+		errorFields = append(errorFields, errorField)
+	}
+
+	return New(code, message, errorFields...), len(errorFields) > 0
+}
+
+// ParseFieldLines builds an error from validator output made of "field=reason"
+// lines, one error field per line. Lines without "=" or with an empty field
+// name are skipped.
+func ParseFieldLines(code int, message, text string) Error {
+	var (
+		lines       []string = strings.Split(text, "\n")
+		field       string
+		reason      string
+		isFieldLine bool
+		errorFields []ErrorField
+	)
+
+	for i := 0; i < len(lines); i++ {
+		field, reason, isFieldLine = strings.Cut(strings.TrimSuffix(lines[i], "\r"), "=")
+		field = strings.TrimSpace(field)
+		if !isFieldLine || field == "" {
+			continue
+		}
+		errorFields = append(errorFields, NewErrorField(field, strings.TrimSpace(reason)))
+	}
+
+	return New(code, message, errorFields...)
+}
+
+// FirstFailure runs validators in order and stops at the first one that
+// reports !ok, returning an error with that single field. The remaining
+// validators are not run. It returns false when every validator passes.
+func FirstFailure(code int, message string, validators ...func() (field, msg string, ok bool)) (Error, bool) {
+	var (
+		field string
+		msg   string
+		ok    bool
+	)
+
+	for i := 0; i < len(validators); i++ {
+		field, msg, ok = validators[i]()
+		if !ok {
+			return New(code, message, NewErrorField(field, msg)), true
+		}
+	}
+
+	return Error{}, false
 }
 
 func (e Error) Error() string {
 	return e.Message
 }
 
+func (e Error) Unwrap() error {
+	return e.Cause
+}
+
+// HasCause reports whether a custom error in the wrap chain of err carries a
+// non-nil cause.
+func HasCause(err error) bool {
+	var (
+		customError   Error
+		isCustomError bool
+	)
+
+	for ; err != nil; err = errors.Unwrap(err) {
+		customError, isCustomError = err.(Error)
+		if isCustomError && customError.Cause != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RootCause returns the innermost error of the wrap chain of err when it is
+// not a custom error, such as the io.EOF wrapped by a cause. It returns nil
+// when the chain ends in a custom error, that is, when the innermost custom
+// error has no cause.
+func RootCause(err error) error {
+	var (
+		next          error
+		isCustomError bool
+	)
+
+	if err == nil {
+		return nil
+	}
+
+	for next = errors.Unwrap(err); next != nil; next = errors.Unwrap(err) {
+		err = next
+	}
+
+	_, isCustomError = err.(Error)
+	if isCustomError {
+		return nil
+	}
+
+	return err
+}
+
+// MessageOrDefault returns the message when it is not empty, otherwise the
+// standard HTTP status text of the code, otherwise the generic "error".
+func (e Error) MessageOrDefault() string {
+	var statusText string
+
+	if e.Message != "" {
+		return e.Message
+	}
+
+	statusText = http.StatusText(e.Code)
+	if statusText != "" {
+		return statusText
+	}
+
+	return "error"
+}
+
+// IsEmpty reports whether e carries no code, message, message key, error
+// fields, cause, trace ID or timestamp.
+func (e Error) IsEmpty() bool {
+	return e.Code == 0 && e.Message == "" && e.MessageKey == "" && len(e.ErrorFields) == 0 &&
+		e.Cause == nil && e.TraceID == "" && e.Timestamp.IsZero()
+}
+
+// OrElse returns e when it is not empty, otherwise fallback.
+func (e Error) OrElse(fallback Error) Error {
+	if !e.IsEmpty() {
+		return e
+	}
+
+	return fallback
+}
+
+// Parse returns the custom error held by err. When err is not a custom error
+// itself, its wrap chain is searched with errors.As, so errors wrapped with
+// fmt.Errorf("...: %w", customErr) are found as well.
 func Parse(err error) (Error, bool) {
 	var (
 		customError   Error
@@ -31,8 +248,56 @@ func Parse(err error) (Error, bool) {
 	}
 
 	customError, isCustomError = err.(Error)
+	if isCustomError {
+		return customError, true
+	}
 
-	return customError, isCustomError
+	if errors.As(err, &customError) {
+		return customError, true
+	}
+
+	return Error{}, false
+}
+
+var stdErrors = []struct {
+	err     error
+	code    int
+	message string
+}{
+	{err: context.DeadlineExceeded, code: http.StatusGatewayTimeout, message: "Gateway Timeout"},
+	{err: context.Canceled, code: 499, message: "Client Closed Request"},
+	{err: fs.ErrNotExist, code: http.StatusNotFound, message: "Not Found"},
+	{err: fs.ErrPermission, code: http.StatusForbidden, message: "Forbidden"},
+	{err: fs.ErrExist, code: http.StatusConflict, message: "Conflict"},
+}
+
+// Normalize turns any error into a custom error. A custom error found in the
+// chain of err is returned as is, known standard library sentinels (context
+// deadline and cancellation, fs not-exist, permission and exist errors) are
+// mapped to a matching code, and anything else becomes a 500. Except for
+// custom errors, err is kept as the cause. A nil err yields an empty error.
+func Normalize(err error) Error {
+	var (
+		customError   Error
+		isCustomError bool
+	)
+
+	if err == nil {
+		return Error{}
+	}
+
+	customError, isCustomError = Parse(err)
+	if isCustomError {
+		return customError
+	}
+
+	for i := 0; i < len(stdErrors); i++ {
+		if errors.Is(err, stdErrors[i].err) {
+			return Wrap(stdErrors[i].code, stdErrors[i].message, err)
+		}
+	}
+
+	return Wrap(http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError), err)
 }
 
 func GetErrorCode(err error) int {
@@ -52,3 +317,680 @@ func GetErrorCode(err error) int {
 func IsErrorCodeEqual(err error, code int) bool {
 	return GetErrorCode(err) == code
 }
+
+// Recode returns err with its code replaced by newCode, keeping the message
+// and error fields. A non-custom err becomes a new error with newCode and the
+// original error message that wraps err as its cause.
+func Recode(err error, newCode int) Error {
+	var (
+		customError   Error
+		isCustomError bool
+	)
+
+	customError, isCustomError = Parse(err)
+	if !isCustomError {
+		if err == nil {
+			return New(newCode, "")
+		}
+		return Wrap(newCode, err.Error(), err)
+	}
+
+	customError.Code = newCode
+
+	return customError
+}
+
+// EqualIgnoring reports whether e and other have the same code, message,
+// message key, error fields, trace ID and timestamp. The ignore names select volatile
+// aspects (such as "timestamp", "traceid" or "stack") to leave out of the
+// comparison; names of aspects the error does not carry have no effect.
+func (e Error) EqualIgnoring(other Error, ignore ...string) bool {
+	var ignored map[string]bool = make(map[string]bool, len(ignore))
+
+	for i := 0; i < len(ignore); i++ {
+		ignored[ignore[i]] = true
+	}
+
+	if e.Code != other.Code || e.Message != other.Message || e.MessageKey != other.MessageKey {
+		return false
+	}
+
+	if !ignored["traceid"] && e.TraceID != other.TraceID {
+		return false
+	}
+
+	if !ignored["timestamp"] && !e.Timestamp.Equal(other.Timestamp) {
+		return false
+	}
+
+	if len(e.ErrorFields) != len(other.ErrorFields) {
+		return false
+	}
+
+	for i := 0; i < len(e.ErrorFields); i++ {
+		if e.ErrorFields[i] != other.ErrorFields[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal reports whether a and b are both custom errors with the same code,
+// message, message key and error fields in the same order. Trace IDs and timestamps are
+// not compared.
+func Equal(a, b error) bool {
+	var (
+		customA Error
+		customB Error
+		isA     bool
+		isB     bool
+	)
+
+	customA, isA = Parse(a)
+	customB, isB = Parse(b)
+	if !isA || !isB {
+		return false
+	}
+
+	return customA.EqualIgnoring(customB, "traceid", "timestamp")
+}
+
+// EqualIgnoreOrder is like Equal but accepts the error fields in any order.
+func EqualIgnoreOrder(a, b error) bool {
+	var (
+		customA Error
+		customB Error
+		isA     bool
+		isB     bool
+	)
+
+	customA, isA = Parse(a)
+	customB, isB = Parse(b)
+	if !isA || !isB {
+		return false
+	}
+
+	customA.ErrorFields = sortedErrorFields(customA.ErrorFields)
+	customB.ErrorFields = sortedErrorFields(customB.ErrorFields)
+
+	return customA.EqualIgnoring(customB, "traceid", "timestamp")
+}
+
+// FieldMask is the message MaskFields puts in place of a masked field's message.
+const FieldMask string = "***"
+
+// MaskFields returns a copy of e where the message of every error field named
+// in fieldNames is replaced with FieldMask. Other fields are left intact.
+func (e Error) MaskFields(fieldNames ...string) Error {
+	var (
+		masked  Error = e
+		masking map[string]bool
+	)
+
+	masking = make(map[string]bool, len(fieldNames))
+	for i := 0; i < len(fieldNames); i++ {
+		masking[fieldNames[i]] = true
+	}
+
+	masked.ErrorFields = make([]ErrorField, len(e.ErrorFields))
+	copy(masked.ErrorFields, e.ErrorFields)
+
+	for i := 0; i < len(masked.ErrorFields); i++ {
+		if masking[masked.ErrorFields[i].Field] {
+			masked.ErrorFields[i].Message = FieldMask
+		}
+	}
+
+	return masked
+}
+
+// WithContextf returns a copy of e whose message has the formatted context
+// appended in parentheses. An empty message becomes just the parenthesized
+// context.
+func (e Error) WithContextf(format string, args ...any) Error {
+	var (
+		annotated  Error  = e
+		annotation string = "(" + fmt.Sprintf(format, args...) + ")"
+	)
+
+	if annotated.Message == "" {
+		annotated.Message = annotation
+		return annotated
+	}
+
+	annotated.Message += " " + annotation
+
+	return annotated
+}
+
+// UnjoinErrors extracts every custom error from err, flattening errors
+// produced by errors.Join (or any error exposing Unwrap() []error)
+// recursively. Members that are not custom errors are skipped.
+func UnjoinErrors(err error) []Error {
+	var (
+		customError   Error
+		isCustomError bool
+		joinedErr     interface{ Unwrap() []error }
+		isJoinedErr   bool
+		members       []error
+		customErrors  []Error
+	)
+
+	joinedErr, isJoinedErr = err.(interface{ Unwrap() []error })
+	if !isJoinedErr {
+		customError, isCustomError = Parse(err)
+		if isCustomError {
+			return []Error{customError}
+		}
+		return nil
+	}
+
+	members = joinedErr.Unwrap()
+	for i := 0; i < len(members); i++ {
+		customErrors = append(customErrors, UnjoinErrors(members[i])...)
+	}
+
+	return customErrors
+}
+
+// FieldsMatch reports whether the error fields of e are exactly the
+// field to message pairs in expected, ignoring order.
+func (e Error) FieldsMatch(expected map[string]string) bool {
+	var (
+		message string
+		exists  bool
+		matched map[string]bool
+	)
+
+	if len(e.ErrorFields) != len(expected) {
+		return false
+	}
+
+	matched = make(map[string]bool, len(expected))
+	for i := 0; i < len(e.ErrorFields); i++ {
+		message, exists = expected[e.ErrorFields[i].Field]
+		if !exists || matched[e.ErrorFields[i].Field] || message != e.ErrorFields[i].Message {
+			return false
+		}
+		matched[e.ErrorFields[i].Field] = true
+	}
+
+	return true
+}
+
+// moreSevere reports whether a ranks above b: the higher code class
+// (code / 100) wins, then the higher code.
+func moreSevere(a, b Error) bool {
+	if a.Code/100 != b.Code/100 {
+		return a.Code/100 > b.Code/100
+	}
+
+	return a.Code > b.Code
+}
+
+// CombineWith returns a new error holding the error fields of e followed by
+// those of other not already present in e. The code and message are taken
+// from the more severe of the two: the higher code class (code / 100) wins,
+// then the higher code; when both codes are equal e wins.
+func (e Error) CombineWith(other Error) Error {
+	var (
+		combined Error = e
+		existing map[ErrorField]bool
+	)
+
+	if moreSevere(other, e) {
+		combined = other
+	}
+
+	existing = make(map[ErrorField]bool, len(e.ErrorFields))
+	combined.ErrorFields = make([]ErrorField, 0, len(e.ErrorFields)+len(other.ErrorFields))
+
+	for i := 0; i < len(e.ErrorFields); i++ {
+		existing[e.ErrorFields[i]] = true
+		combined.ErrorFields = append(combined.ErrorFields, e.ErrorFields[i])
+	}
+
+	for i := 0; i < len(other.ErrorFields); i++ {
+		if !existing[other.ErrorFields[i]] {
+			existing[other.ErrorFields[i]] = true
+			combined.ErrorFields = append(combined.ErrorFields, other.ErrorFields[i])
+		}
+	}
+
+	return combined
+}
+
+// MostSevere parses every error in errs and returns the most severe custom
+// error, ranked like CombineWith: the higher code class (code / 100) wins,
+// then the higher code; among equal codes the first one wins. It returns false
+// when none of errs is a custom error.
+func MostSevere(errs ...error) (Error, bool) {
+	var (
+		mostSevere    Error
+		found         bool
+		customError   Error
+		isCustomError bool
+	)
+
+	for i := 0; i < len(errs); i++ {
+		customError, isCustomError = Parse(errs[i])
+		if !isCustomError {
+			continue
+		}
+
+		if !found || moreSevere(customError, mostSevere) {
+			mostSevere = customError
+			found = true
+		}
+	}
+
+	return mostSevere, found
+}
+
+// RenameFields returns a copy of e where every error field whose name is a
+// key of rename is renamed to the mapped value. Other fields are unchanged.
+func (e Error) RenameFields(rename map[string]string) Error {
+	var (
+		renamed Error = e
+		newName string
+		exists  bool
+	)
+
+	renamed.ErrorFields = make([]ErrorField, len(e.ErrorFields))
+	copy(renamed.ErrorFields, e.ErrorFields)
+
+	for i := 0; i < len(renamed.ErrorFields); i++ {
+		newName, exists = rename[renamed.ErrorFields[i].Field]
+		if exists {
+			renamed.ErrorFields[i].Field = newName
+		}
+	}
+
+	return renamed
+}
+
+// FieldDensity returns the number of error fields of e as a float64, for use
+// as a per-error monitoring metric alongside AverageFieldCount.
+func (e Error) FieldDensity() float64 {
+	return float64(len(e.ErrorFields))
+}
+
+// AverageFieldCount returns the mean number of error fields across the custom
+// errors in errs. Non-custom errors are ignored; it returns 0 when there are
+// no custom errors.
+func AverageFieldCount(errs []error) float64 {
+	var (
+		customError   Error
+		isCustomError bool
+		errorCount    int
+		fieldCount    int
+	)
+
+	for i := 0; i < len(errs); i++ {
+		customError, isCustomError = Parse(errs[i])
+		if !isCustomError {
+			continue
+		}
+		errorCount++
+		fieldCount += len(customError.ErrorFields)
+	}
+
+	if errorCount == 0 {
+		return 0
+	}
+
+	return float64(fieldCount) / float64(errorCount)
+}
+
+// CoversFields reports whether every name in required has at least one
+// corresponding error field in e. An empty required set is always covered.
+func (e Error) CoversFields(required ...string) bool {
+	var present map[string]bool = make(map[string]bool, len(e.ErrorFields))
+
+	for i := 0; i < len(e.ErrorFields); i++ {
+		present[e.ErrorFields[i].Field] = true
+	}
+
+	for i := 0; i < len(required); i++ {
+		if !present[required[i]] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FieldsConform reports whether every field name of e matches pattern. When
+// some do not, it also returns those names, each once, in order of
+// appearance.
+func (e Error) FieldsConform(pattern *regexp.Regexp) (bool, []string) {
+	var (
+		nonConforming []string
+		seen          map[string]bool = make(map[string]bool)
+	)
+
+	for i := 0; i < len(e.ErrorFields); i++ {
+		if seen[e.ErrorFields[i].Field] || pattern.MatchString(e.ErrorFields[i].Field) {
+			continue
+		}
+		seen[e.ErrorFields[i].Field] = true
+		nonConforming = append(nonConforming, e.ErrorFields[i].Field)
+	}
+
+	return len(nonConforming) == 0, nonConforming
+}
+
+// FieldsRef returns the error fields of e without copying them. The slice is
+// shared with e (and every copy of e), so it must only be read; use
+// GetErrorFields when the result may be modified.
+func (e Error) FieldsRef() []ErrorField {
+	return e.ErrorFields
+}
+
+// Fields returns an iterator over the error fields of e in order, without
+// copying them.
+func (e Error) Fields() iter.Seq[ErrorField] {
+	return func(yield func(ErrorField) bool) {
+		for i := 0; i < len(e.ErrorFields); i++ {
+			if !yield(e.ErrorFields[i]) {
+				return
+			}
+		}
+	}
+}
+
+// RangeFields returns an iterator over the error fields of err, which yields
+// nothing when err is not a custom error.
+func RangeFields(err error) iter.Seq[ErrorField] {
+	var customError Error
+
+	customError, _ = Parse(err)
+
+	return customError.Fields()
+}
+
+// Go runs fn in a new goroutine and sends the error it returns on errCh. A
+// panic inside fn is recovered and sent as an error with the given code
+// instead. Nothing is sent when fn returns nil.
+func Go(fn func() error, errCh chan<- error, code int) {
+	go func() {
+		var err error
+
+		defer func() {
+			var recovered any = recover()
+			if recovered != nil {
+				err = New(code, fmt.Sprintf("panic: %v", recovered))
+			}
+			if err != nil {
+				errCh <- err
+			}
+		}()
+
+		err = fn()
+	}()
+}
+
+// CatchValidation runs fn and recovers a custom error it panics with,
+// returning it with true. It returns false when fn returns normally. Any other
+// panic value is re-panicked unchanged.
+func CatchValidation(fn func()) (customError Error, isCaught bool) {
+	defer func() {
+		var recovered any = recover()
+		if recovered == nil {
+			return
+		}
+
+		customError, isCaught = recovered.(Error)
+		if !isCaught {
+			panic(recovered)
+		}
+	}()
+
+	fn()
+
+	return Error{}, false
+}
+
+// SortedFieldNames returns the distinct field names of e sorted
+// lexicographically. Names are compared as is, without case folding.
+func (e Error) SortedFieldNames() []string {
+	var (
+		names []string = make([]string, 0, len(e.ErrorFields))
+		seen  map[string]bool
+	)
+
+	seen = make(map[string]bool, len(e.ErrorFields))
+	for i := 0; i < len(e.ErrorFields); i++ {
+		if seen[e.ErrorFields[i].Field] {
+			continue
+		}
+		seen[e.ErrorFields[i].Field] = true
+		names = append(names, e.ErrorFields[i].Field)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// IsCodeOnly reports whether err is a custom error with a non-zero code but
+// no message and no error fields, such as New(404, "").
+func IsCodeOnly(err error) bool {
+	var (
+		customError   Error
+		isCustomError bool
+	)
+
+	customError, isCustomError = Parse(err)
+
+	return isCustomError && customError.Code != 0 && customError.Message == "" && len(customError.ErrorFields) == 0
+}
+
+// WithField returns a copy of e with one more error field appended. The
+// receiver is left untouched.
+func (e Error) WithField(field string, message string) Error {
+	return e.WithFields(NewErrorField(field, message))
+}
+
+// WithFields returns a copy of e with errorFields appended. The receiver is
+// left untouched.
+func (e Error) WithFields(errorFields ...ErrorField) Error {
+	var extended Error = e
+
+	extended.ErrorFields = make([]ErrorField, 0, len(e.ErrorFields)+len(errorFields))
+	extended.ErrorFields = append(extended.ErrorFields, e.ErrorFields...)
+	extended.ErrorFields = append(extended.ErrorFields, errorFields...)
+
+	return extended
+}
+
+// WithTraceID returns a copy of e carrying the request or trace ID id.
+func (e Error) WithTraceID(id string) Error {
+	var traced Error = e
+
+	traced.TraceID = id
+
+	return traced
+}
+
+// ContextID, when set, reads the trace or correlation ID carried by a context
+// for WithContextID, returning "" when there is none. Install it during
+// initialization.
+var ContextID func(ctx context.Context) string
+
+// WithContextID returns a copy of e carrying the ID that ContextID reads from
+// ctx as its trace ID. It returns e unchanged when ContextID is not set or
+// ctx carries no ID.
+func (e Error) WithContextID(ctx context.Context) Error {
+	var id string
+
+	if ContextID == nil {
+		return e
+	}
+
+	id = ContextID(ctx)
+	if id == "" {
+		return e
+	}
+
+	return e.WithTraceID(id)
+}
+
+// WithTimestamp returns a copy of e recording ts as the time it occurred.
+func (e Error) WithTimestamp(ts time.Time) Error {
+	var stamped Error = e
+
+	stamped.Timestamp = ts
+
+	return stamped
+}
+
+// AsUserVisible returns a copy of e explicitly marked as safe to show to users.
+func (e Error) AsUserVisible() Error {
+	var (
+		visible     Error = e
+		userVisible bool  = true
+	)
+
+	visible.userVisible = &userVisible
+
+	return visible
+}
+
+// AsHidden returns a copy of e explicitly marked as not safe to show to users,
+// such as a 4xx error whose message leaks internals.
+func (e Error) AsHidden() Error {
+	var (
+		hidden      Error = e
+		userVisible bool  = false
+	)
+
+	hidden.userVisible = &userVisible
+
+	return hidden
+}
+
+// EscalateIfFields returns a copy of e whose code is validationCode when e has
+// error fields. Without error fields the copy is unchanged.
+func (e Error) EscalateIfFields(validationCode int) Error {
+	var escalated Error = e
+
+	if len(e.ErrorFields) > 0 {
+		escalated.Code = validationCode
+	}
+
+	return escalated
+}
+
+// WithoutField returns a copy of e without any error field named field. The
+// receiver is left untouched.
+func (e Error) WithoutField(field string) Error {
+	var remaining Error = e
+
+	remaining.ErrorFields = make([]ErrorField, 0, len(e.ErrorFields))
+	for i := 0; i < len(e.ErrorFields); i++ {
+		if e.ErrorFields[i].Field != field {
+			remaining.ErrorFields = append(remaining.ErrorFields, e.ErrorFields[i])
+		}
+	}
+
+	return remaining
+}
+
+// WithFieldPrefix returns a copy of e where every error field message is
+// prefixed with prefix and a space. The receiver is left untouched.
+func (e Error) WithFieldPrefix(prefix string) Error {
+	var prefixed Error = e
+
+	prefixed.ErrorFields = make([]ErrorField, len(e.ErrorFields))
+	copy(prefixed.ErrorFields, e.ErrorFields)
+
+	for i := 0; i < len(prefixed.ErrorFields); i++ {
+		prefixed.ErrorFields[i].Message = prefix + " " + prefixed.ErrorFields[i].Message
+	}
+
+	return prefixed
+}
+
+// KeepLongestMessages returns a copy of e with a single error field per field
+// name: the one with the longest message, the first one on ties. Fields keep
+// the position of their first occurrence.
+func (e Error) KeepLongestMessages() Error {
+	var (
+		kept     Error          = e
+		position map[string]int = make(map[string]int, len(e.ErrorFields))
+		index    int
+		exists   bool
+	)
+
+	kept.ErrorFields = make([]ErrorField, 0, len(e.ErrorFields))
+	for i := 0; i < len(e.ErrorFields); i++ {
+		index, exists = position[e.ErrorFields[i].Field]
+		if !exists {
+			position[e.ErrorFields[i].Field] = len(kept.ErrorFields)
+			kept.ErrorFields = append(kept.ErrorFields, e.ErrorFields[i])
+			continue
+		}
+
+		if len(e.ErrorFields[i].Message) > len(kept.ErrorFields[index].Message) {
+			kept.ErrorFields[index] = e.ErrorFields[i]
+		}
+	}
+
+	return kept
+}
+
+// OverflowField names the error field LimitFields appends in place of the
+// fields it drops.
+const OverflowField string = "_overflow"
+
+// LimitFields returns a copy of e with at most max error fields. When fields
+// are dropped, an OverflowField error field with a message such as
+// "and 3 more" is appended after the kept ones.
+func (e Error) LimitFields(max int) Error {
+	var limited Error = e
+
+	if max < 0 {
+		max = 0
+	}
+
+	if len(e.ErrorFields) <= max {
+		limited.ErrorFields = make([]ErrorField, len(e.ErrorFields))
+		copy(limited.ErrorFields, e.ErrorFields)
+		return limited
+	}
+
+	limited.ErrorFields = make([]ErrorField, max, max+1)
+	copy(limited.ErrorFields, e.ErrorFields[:max])
+	limited.ErrorFields = append(limited.ErrorFields, NewErrorField(OverflowField, fmt.Sprintf("and %d more", len(e.ErrorFields)-max)))
+
+	return limited
+}
+
+// AppendFieldsFrom returns a copy of e with the error fields of other named
+// in fieldNames appended, or all of them when no name is given. Nothing is
+// appended when other is not a custom error.
+func (e Error) AppendFieldsFrom(other error, fieldNames ...string) Error {
+	var (
+		appended    Error = e
+		otherFields []ErrorField
+		selected    map[string]bool = make(map[string]bool, len(fieldNames))
+	)
+
+	otherFields = GetErrorFields(other)
+	for i := 0; i < len(fieldNames); i++ {
+		selected[fieldNames[i]] = true
+	}
+
+	appended.ErrorFields = make([]ErrorField, 0, len(e.ErrorFields)+len(otherFields))
+	appended.ErrorFields = append(appended.ErrorFields, e.ErrorFields...)
+
+	for i := 0; i < len(otherFields); i++ {
+		if len(fieldNames) == 0 || selected[otherFields[i].Field] {
+			appended.ErrorFields = append(appended.ErrorFields, otherFields[i])
+		}
+	}
+
+	return appended
+}
@@ -0,0 +1,163 @@
+package gocerr
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// binaryVersion is the format written by MarshalBinary.
+const binaryVersion byte = 1
+
+var ErrInvalidBinary error = errors.New("gocerr: invalid binary encoding")
+
+// MarshalBinary encodes e as a version byte followed by the code as a varint,
+// the message, the number of error fields as a uvarint, every error field as
+// its field, code and message, the trace ID and the timestamp in the form of
+// time.Time.MarshalBinary (empty when zero). Strings and the timestamp are
+// prefixed with their length as a uvarint. The cause, the message keys and the
+// retryable and user-visibility overrides are not encoded.
+func (e Error) MarshalBinary() ([]byte, error) {
+	var (
+		data      []byte = make([]byte, 0, 32+len(e.Message))
+		timestamp []byte
+		err       error
+	)
+
+	if !e.Timestamp.IsZero() {
+		timestamp, err = e.Timestamp.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	data = append(data, binaryVersion)
+	data = binary.AppendVarint(data, int64(e.Code))
+	data = appendBinaryString(data, e.Message)
+	data = binary.AppendUvarint(data, uint64(len(e.ErrorFields)))
+
+	for i := 0; i < len(e.ErrorFields); i++ {
+		data = appendBinaryString(data, e.ErrorFields[i].Field)
+		data = appendBinaryString(data, e.ErrorFields[i].Code)
+		data = appendBinaryString(data, e.ErrorFields[i].Message)
+	}
+
+	data = appendBinaryString(data, e.TraceID)
+	data = appendBinaryString(data, string(timestamp))
+
+	return data, nil
+}
+
+func (e *Error) UnmarshalBinary(data []byte) error {
+	var (
+		code        int64
+		message     string
+		count       uint64
+		errorFields []ErrorField
+		errorField  ErrorField
+		version     byte
+		traceID     string
+		timestamp   string
+		ts          time.Time
+		n           int
+		err         error
+	)
+
+	if len(data) == 0 {
+		return ErrInvalidBinary
+	}
+
+	version = data[0]
+	if version != binaryVersion {
+		return fmt.Errorf("%w: unsupported version %d", ErrInvalidBinary, version)
+	}
+	data = data[1:]
+
+	code, n = binary.Varint(data)
+	if n <= 0 {
+		return ErrInvalidBinary
+	}
+	data = data[n:]
+
+	message, data, err = readBinaryString(data)
+	if err != nil {
+		return err
+	}
+
+	count, n = binary.Uvarint(data)
+	if n <= 0 || count > uint64(len(data)) {
+		return ErrInvalidBinary
+	}
+	data = data[n:]
+
+	for i := uint64(0); i < count; i++ {
+		errorField.Field, data, err = readBinaryString(data)
+		if err != nil {
+			return err
+		}
+
+		errorField.Code, data, err = readBinaryString(data)
+		if err != nil {
+			return err
+		}
+
+		errorField.Message, data, err = readBinaryString(data)
+		if err != nil {
+			return err
+		}
+
+		errorFields = append(errorFields, errorField)
+	}
+
+	traceID, data, err = readBinaryString(data)
+	if err != nil {
+		return err
+	}
+
+	timestamp, data, err = readBinaryString(data)
+	if err != nil {
+		return err
+	}
+
+	if timestamp != "" {
+		err = ts.UnmarshalBinary([]byte(timestamp))
+		if err != nil {
+			return ErrInvalidBinary
+		}
+	}
+
+	if len(data) != 0 {
+		return ErrInvalidBinary
+	}
+
+	*e = Error{
+		Code:        int(code),
+		Message:     message,
+		ErrorFields: errorFields,
+		TraceID:     traceID,
+		Timestamp:   ts,
+	}
+
+	return nil
+}
+
+func appendBinaryString(data []byte, s string) []byte {
+	data = binary.AppendUvarint(data, uint64(len(s)))
+	return append(data, s...)
+}
+
+func readBinaryString(data []byte) (string, []byte, error) {
+	var (
+		length uint64
+		n      int
+	)
+
+	length, n = binary.Uvarint(data)
+	if n <= 0 || length > uint64(len(data)-n) {
+		return "", nil, ErrInvalidBinary
+	}
+	data = data[n:]
+
+	return string(data[:length]), data[length:], nil
+}
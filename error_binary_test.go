@@ -0,0 +1,94 @@
+package gocerr
+
+import (
+	"encoding"
+	"errors"
+	"testing"
+	"time"
+)
+
+var _ encoding.BinaryMarshaler = Error{}
+var _ encoding.BinaryUnmarshaler = (*Error)(nil)
+
+func TestError_UnmarshalBinary(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Error Error
+	}{
+		{
+			Name:  "empty error fields",
+			Error: New(500, "internal server error"),
+		},
+		{
+			Name: "with error fields",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("field1", "field is required"),
+				NewErrorFieldWithCode("field2", "min", "min value is 50"),
+			),
+		},
+		{
+			Name: "unicode messages",
+			Error: New(
+				422,
+				"permintaan tidak valid ✗",
+				NewErrorField("名前", "必須です"),
+				NewErrorField("emoji", "🚫 not allowed"),
+			),
+		},
+		{
+			Name:  "negative code",
+			Error: New(-1, ""),
+		},
+		{
+			Name: "trace id and timestamp",
+			Error: NewAt(
+				500,
+				"internal server error",
+				time.Date(2024, time.March, 1, 10, 30, 0, 123, time.FixedZone("WIB", 7*60*60)),
+			).WithTraceID("req-123"),
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual Error
+
+			data, err := testCases[i].Error.MarshalBinary()
+			if err != nil {
+				t.Fatalf("expected no marshal error, but got %v", err)
+			}
+
+			err = actual.UnmarshalBinary(data)
+			if err != nil {
+				t.Fatalf("expected no unmarshal error, but got %v", err)
+			}
+
+			if !testCases[i].Error.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Error.String(), actual.String())
+			}
+		})
+	}
+
+	t.Run("invalid data", func(t *testing.T) {
+		var (
+			actual Error
+			data   []byte
+		)
+
+		data, _ = New(400, "bad request", NewErrorField("field1", "field is required")).MarshalBinary()
+
+		if !errors.Is(actual.UnmarshalBinary(nil), ErrInvalidBinary) {
+			t.Errorf("expected error of empty data is %v", ErrInvalidBinary)
+		}
+
+		if !errors.Is(actual.UnmarshalBinary(data[:len(data)-3]), ErrInvalidBinary) {
+			t.Errorf("expected error of truncated data is %v", ErrInvalidBinary)
+		}
+
+		if !errors.Is(actual.UnmarshalBinary(append([]byte{2}, data[1:]...)), ErrInvalidBinary) {
+			t.Errorf("expected error of unsupported version is %v", ErrInvalidBinary)
+		}
+	})
+}
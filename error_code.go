@@ -0,0 +1,243 @@
+package gocerr
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Code is an error code with classification helpers. Error.Code stays a
+// plain int, so convert with Code(e.Code) and pass int(c) to New.
+type Code int
+
+func (c Code) IsClientError() bool {
+	return c >= 400 && c <= 499
+}
+
+func (c Code) IsServerError() bool {
+	return c >= 500 && c <= 599
+}
+
+// IsClientError reports whether err is a custom error with a 4xx code.
+func IsClientError(err error) bool {
+	return Code(GetErrorCode(err)).IsClientError()
+}
+
+// IsServerError reports whether err is a custom error with a 5xx code.
+func IsServerError(err error) bool {
+	return Code(GetErrorCode(err)).IsServerError()
+}
+
+var (
+	codeNamesMutex sync.RWMutex
+	codeNames      map[int]string = map[int]string{}
+)
+
+// RegisterCode associates a human readable name, such as "BadRequest", with
+// code. Registration is meant to happen during initialization; lookups are
+// safe for concurrent use.
+func RegisterCode(code int, name string) {
+	codeNamesMutex.Lock()
+	defer codeNamesMutex.Unlock()
+
+	codeNames[code] = name
+}
+
+// CodeName returns the name registered for code, or an empty string.
+func CodeName(code int) string {
+	codeNamesMutex.RLock()
+	defer codeNamesMutex.RUnlock()
+
+	return codeNames[code]
+}
+
+func statusTextName(statusText string) string {
+	var (
+		words   []string = strings.Fields(statusText)
+		builder strings.Builder
+		word    []rune
+	)
+
+	for i := 0; i < len(words); i++ {
+		word = []rune(strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return r
+			}
+			return -1
+		}, words[i]))
+		if len(word) == 0 {
+			continue
+		}
+		word[0] = unicode.ToUpper(word[0])
+		builder.WriteString(string(word))
+	}
+
+	return builder.String()
+}
+
+// RegisterHTTPStatusCodes registers every standard net/http status code under
+// its status text in PascalCase without punctuation, e.g. 400 as "BadRequest".
+func RegisterHTTPStatusCodes() {
+	var statusText string
+
+	for code := 100; code <= 599; code++ {
+		statusText = http.StatusText(code)
+		if statusText != "" {
+			RegisterCode(code, statusTextName(statusText))
+		}
+	}
+}
+
+func isRetryableCode(code int) bool {
+	return code == http.StatusTooManyRequests ||
+		code == http.StatusServiceUnavailable ||
+		code == http.StatusGatewayTimeout
+}
+
+// WithRetryable returns a copy of e explicitly marked as worth retrying or
+// not, overriding the classification by code.
+func (e Error) WithRetryable(retryable bool) Error {
+	var marked Error = e
+
+	marked.retryable = &retryable
+
+	return marked
+}
+
+// IsRetryable reports whether retrying the operation that failed with e makes
+// sense. Unless set with WithRetryable, it is derived from the code: 429, 503
+// and 504 are retryable, any other code (including the remaining 4xx and 5xx)
+// is not.
+func (e Error) IsRetryable() bool {
+	if e.retryable != nil {
+		return *e.retryable
+	}
+
+	return isRetryableCode(e.Code)
+}
+
+// IsRetryable reports whether err is a custom error worth retrying, as
+// classified by Error.IsRetryable. It returns false for other errors.
+func IsRetryable(err error) bool {
+	var (
+		customError   Error
+		isCustomError bool
+	)
+
+	customError, isCustomError = Parse(err)
+
+	return isCustomError && customError.IsRetryable()
+}
+
+// IsFinal reports whether err is a client-caused (4xx) custom error that is
+// not worth retrying, so it can be surfaced to the user immediately.
+func IsFinal(err error) bool {
+	return Code(GetErrorCode(err)).IsClientError() && !IsRetryable(err)
+}
+
+// IsCacheable reports whether err is a deterministic client error that a
+// cache may store: any 4xx code except 401 (depends on credentials) and 429
+// (depends on rate-limit state). Server errors and non-custom errors are not
+// cacheable.
+func IsCacheable(err error) bool {
+	var code int = GetErrorCode(err)
+
+	return Code(code).IsClientError() &&
+		code != http.StatusUnauthorized &&
+		code != http.StatusTooManyRequests
+}
+
+// IsTransient reports whether err is likely to go away on its own: a 502, 503
+// or 504 custom error, or an error whose chain holds context.DeadlineExceeded
+// or a net.Error reporting a timeout.
+func IsTransient(err error) bool {
+	var (
+		code   int = GetErrorCode(err)
+		netErr net.Error
+	)
+
+	if code == http.StatusBadGateway ||
+		code == http.StatusServiceUnavailable ||
+		code == http.StatusGatewayTimeout {
+		return true
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// ErrorRateOverrides forces whether a code counts toward the error rate,
+// taking precedence over the default classification of CountsTowardErrorRate.
+// Configure it during initialization.
+var ErrorRateOverrides map[int]bool = map[int]bool{}
+
+// CountsTowardErrorRate reports whether err is a real failure for SLO
+// tracking: 5xx codes count, other codes (such as 4xx client errors) do not,
+// unless the code is listed in ErrorRateOverrides. A non-nil error that is not
+// a custom error counts, since its cause is unknown.
+func CountsTowardErrorRate(err error) bool {
+	var (
+		customError   Error
+		isCustomError bool
+		counts        bool
+		overridden    bool
+	)
+
+	if err == nil {
+		return false
+	}
+
+	customError, isCustomError = Parse(err)
+	if !isCustomError {
+		return true
+	}
+
+	counts, overridden = ErrorRateOverrides[customError.Code]
+	if overridden {
+		return counts
+	}
+
+	return Code(customError.Code).IsServerError()
+}
+
+// IsUserVisible reports whether err is a custom error whose message is safe
+// to show to users: errors marked with AsUserVisible are and errors marked
+// with AsHidden are not, otherwise 4xx errors are and everything else (such as
+// 5xx) is not.
+func IsUserVisible(err error) bool {
+	var (
+		customError   Error
+		isCustomError bool
+	)
+
+	customError, isCustomError = Parse(err)
+	if !isCustomError {
+		return false
+	}
+
+	if customError.userVisible != nil {
+		return *customError.userVisible
+	}
+
+	return Code(customError.Code).IsClientError()
+}
+
+// RequiresAuth reports whether err is a custom error asking for
+// authentication: 401 Unauthorized or 407 Proxy Authentication Required.
+func RequiresAuth(err error) bool {
+	var code int = GetErrorCode(err)
+
+	return code == http.StatusUnauthorized || code == http.StatusProxyAuthRequired
+}
+
+// RequiresPayment reports whether err is a custom error with code 402.
+func RequiresPayment(err error) bool {
+	return IsErrorCodeEqual(err, http.StatusPaymentRequired)
+}
@@ -0,0 +1,509 @@
+package gocerr
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestIsFinal(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected bool
+	}{
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Expected: false,
+		},
+		{
+			Name:     "bad request is not retryable",
+			Error:    New(http.StatusBadRequest, "bad request"),
+			Expected: true,
+		},
+		{
+			Name:     "too many requests is retryable",
+			Error:    New(http.StatusTooManyRequests, "too many requests"),
+			Expected: false,
+		},
+		{
+			Name:     "bad request marked retryable",
+			Error:    New(http.StatusBadRequest, "bad request").WithRetryable(true),
+			Expected: false,
+		},
+		{
+			Name:     "internal server error",
+			Error:    New(http.StatusInternalServerError, "internal server error"),
+			Expected: false,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = IsFinal(testCases[i].Error)
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected is final is %t, but got %t", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected bool
+	}{
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Expected: false,
+		},
+		{
+			Name:     "too many requests",
+			Error:    New(http.StatusTooManyRequests, "too many requests"),
+			Expected: true,
+		},
+		{
+			Name:     "service unavailable",
+			Error:    New(http.StatusServiceUnavailable, "service unavailable"),
+			Expected: true,
+		},
+		{
+			Name:     "gateway timeout",
+			Error:    New(http.StatusGatewayTimeout, "gateway timeout"),
+			Expected: true,
+		},
+		{
+			Name:     "bad request",
+			Error:    New(http.StatusBadRequest, "bad request"),
+			Expected: false,
+		},
+		{
+			Name:     "internal server error",
+			Error:    New(http.StatusInternalServerError, "internal server error"),
+			Expected: false,
+		},
+		{
+			Name:     "bad request marked retryable",
+			Error:    New(http.StatusBadRequest, "bad request").WithRetryable(true),
+			Expected: true,
+		},
+		{
+			Name:     "service unavailable marked not retryable",
+			Error:    New(http.StatusServiceUnavailable, "service unavailable").WithRetryable(false),
+			Expected: false,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = IsRetryable(testCases[i].Error)
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected is retryable is %t, but got %t", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestIsTransient(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected bool
+	}{
+		{
+			Name:     "error is nil",
+			Error:    nil,
+			Expected: false,
+		},
+		{
+			Name:     "service unavailable",
+			Error:    New(http.StatusServiceUnavailable, "service unavailable"),
+			Expected: true,
+		},
+		{
+			Name:     "cause is deadline exceeded",
+			Error:    Wrap(http.StatusInternalServerError, "internal server error", context.DeadlineExceeded),
+			Expected: true,
+		},
+		{
+			Name:     "cause is net timeout error",
+			Error:    Wrap(http.StatusInternalServerError, "internal server error", &net.OpError{Op: "dial", Err: timeoutError{}}),
+			Expected: true,
+		},
+		{
+			Name:     "bad request",
+			Error:    New(http.StatusBadRequest, "bad request"),
+			Expected: false,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = IsTransient(testCases[i].Error)
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected is transient is %t, but got %t", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestIsCacheable(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected bool
+	}{
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Expected: false,
+		},
+		{
+			Name:     "bad request",
+			Error:    New(http.StatusBadRequest, "bad request"),
+			Expected: true,
+		},
+		{
+			Name:     "unauthorized",
+			Error:    New(http.StatusUnauthorized, "unauthorized"),
+			Expected: false,
+		},
+		{
+			Name:     "not found",
+			Error:    New(http.StatusNotFound, "not found"),
+			Expected: true,
+		},
+		{
+			Name:     "too many requests",
+			Error:    New(http.StatusTooManyRequests, "too many requests"),
+			Expected: false,
+		},
+		{
+			Name:     "internal server error",
+			Error:    New(http.StatusInternalServerError, "internal server error"),
+			Expected: false,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = IsCacheable(testCases[i].Error)
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected is cacheable is %t, but got %t", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestCountsTowardErrorRate(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Error     error
+		Overrides map[int]bool
+		Expected  bool
+	}{
+		{
+			Name:     "error is nil",
+			Error:    nil,
+			Expected: false,
+		},
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Expected: true,
+		},
+		{
+			Name:     "client error",
+			Error:    New(http.StatusBadRequest, "bad request"),
+			Expected: false,
+		},
+		{
+			Name:     "server error",
+			Error:    New(http.StatusInternalServerError, "internal server error"),
+			Expected: true,
+		},
+		{
+			Name:      "client error overridden to count",
+			Error:     New(http.StatusTooManyRequests, "too many requests"),
+			Overrides: map[int]bool{http.StatusTooManyRequests: true},
+			Expected:  true,
+		},
+		{
+			Name:      "server error overridden to not count",
+			Error:     New(http.StatusNotImplemented, "not implemented"),
+			Overrides: map[int]bool{http.StatusNotImplemented: false},
+			Expected:  false,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				defaultOverrides map[int]bool = ErrorRateOverrides
+				actual           bool
+			)
+
+			if testCases[i].Overrides != nil {
+				ErrorRateOverrides = testCases[i].Overrides
+			}
+			defer func() {
+				ErrorRateOverrides = defaultOverrides
+			}()
+
+			actual = CountsTowardErrorRate(testCases[i].Error)
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected counts toward error rate is %t, but got %t", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestCodeName(t *testing.T) {
+	defer func() {
+		codeNames = map[int]string{}
+	}()
+
+	RegisterCode(1001, "PaymentDeclined")
+
+	testCases := []struct {
+		Name     string
+		Code     int
+		Expected string
+	}{
+		{
+			Name:     "registered code",
+			Code:     1001,
+			Expected: "PaymentDeclined",
+		},
+		{
+			Name:     "unregistered code",
+			Code:     1002,
+			Expected: "",
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = CodeName(testCases[i].Code)
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected code name is %s, but got %s", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestRegisterHTTPStatusCodes(t *testing.T) {
+	defer func() {
+		codeNames = map[int]string{}
+	}()
+
+	RegisterHTTPStatusCodes()
+
+	testCases := []struct {
+		Code     int
+		Expected string
+	}{
+		{Code: http.StatusBadRequest, Expected: "BadRequest"},
+		{Code: http.StatusNonAuthoritativeInfo, Expected: "NonAuthoritativeInformation"},
+		{Code: http.StatusTeapot, Expected: "ImATeapot"},
+		{Code: 799, Expected: ""},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		if actual := CodeName(testCases[i].Code); testCases[i].Expected != actual {
+			t.Errorf("expected code name of %d is %s, but got %s", testCases[i].Code, testCases[i].Expected, actual)
+		}
+	}
+
+	if actual := New(http.StatusNotFound, "user not found").String(); actual != "[404 NotFound] user not found" {
+		t.Errorf("expected string is %s, but got %s", "[404 NotFound] user not found", actual)
+	}
+
+	if actual := New(799, "unknown").String(); actual != "[799] unknown" {
+		t.Errorf("expected string is %s, but got %s", "[799] unknown", actual)
+	}
+}
+
+func TestIsUserVisible(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected bool
+	}{
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Expected: false,
+		},
+		{
+			Name:     "client error by default",
+			Error:    New(http.StatusBadRequest, "bad request"),
+			Expected: true,
+		},
+		{
+			Name:     "server error by default",
+			Error:    New(http.StatusInternalServerError, "internal server error"),
+			Expected: false,
+		},
+		{
+			Name:     "server error explicitly user visible",
+			Error:    New(http.StatusServiceUnavailable, "maintenance in progress").AsUserVisible(),
+			Expected: true,
+		},
+		{
+			Name:     "client error explicitly hidden",
+			Error:    New(http.StatusBadRequest, "pq: duplicate key value violates unique constraint").AsHidden(),
+			Expected: false,
+		},
+		{
+			Name:     "hidden error made user visible again",
+			Error:    New(http.StatusBadRequest, "bad request").AsHidden().AsUserVisible(),
+			Expected: true,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = IsUserVisible(testCases[i].Error)
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected is user visible is %t, but got %t", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestCode_Classification(t *testing.T) {
+	testCases := []struct {
+		Code     Code
+		Expected struct {
+			IsClientError bool
+			IsServerError bool
+		}
+	}{
+		{Code: 399},
+		{Code: 400, Expected: struct {
+			IsClientError bool
+			IsServerError bool
+		}{IsClientError: true}},
+		{Code: 499, Expected: struct {
+			IsClientError bool
+			IsServerError bool
+		}{IsClientError: true}},
+		{Code: 500, Expected: struct {
+			IsClientError bool
+			IsServerError bool
+		}{IsServerError: true}},
+		{Code: 599, Expected: struct {
+			IsClientError bool
+			IsServerError bool
+		}{IsServerError: true}},
+		{Code: 600},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(strconv.Itoa(int(testCases[i].Code)), func(t *testing.T) {
+			var err error = New(int(testCases[i].Code), "some error")
+
+			if testCases[i].Expected.IsClientError != testCases[i].Code.IsClientError() {
+				t.Errorf("expected code is client error is %t, but got %t", testCases[i].Expected.IsClientError, testCases[i].Code.IsClientError())
+			}
+
+			if testCases[i].Expected.IsServerError != testCases[i].Code.IsServerError() {
+				t.Errorf("expected code is server error is %t, but got %t", testCases[i].Expected.IsServerError, testCases[i].Code.IsServerError())
+			}
+
+			if testCases[i].Expected.IsClientError != IsClientError(err) {
+				t.Errorf("expected error is client error is %t, but got %t", testCases[i].Expected.IsClientError, IsClientError(err))
+			}
+
+			if testCases[i].Expected.IsServerError != IsServerError(err) {
+				t.Errorf("expected error is server error is %t, but got %t", testCases[i].Expected.IsServerError, IsServerError(err))
+			}
+		})
+	}
+
+	if IsClientError(errors.New("some error")) || IsServerError(errors.New("some error")) {
+		t.Errorf("expected non custom error is neither client nor server error")
+	}
+}
+
+func TestRequiresAuthAndPayment(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected struct {
+			RequiresAuth    bool
+			RequiresPayment bool
+		}
+	}{
+		{
+			Name:  "unauthorized",
+			Error: New(http.StatusUnauthorized, "unauthorized"),
+			Expected: struct {
+				RequiresAuth    bool
+				RequiresPayment bool
+			}{
+				RequiresAuth: true,
+			},
+		},
+		{
+			Name:  "proxy authentication required",
+			Error: New(http.StatusProxyAuthRequired, "proxy authentication required"),
+			Expected: struct {
+				RequiresAuth    bool
+				RequiresPayment bool
+			}{
+				RequiresAuth: true,
+			},
+		},
+		{
+			Name:  "payment required",
+			Error: New(http.StatusPaymentRequired, "payment required"),
+			Expected: struct {
+				RequiresAuth    bool
+				RequiresPayment bool
+			}{
+				RequiresPayment: true,
+			},
+		},
+		{
+			Name:  "forbidden",
+			Error: New(http.StatusForbidden, "forbidden"),
+		},
+		{
+			Name:  "error is not custom error",
+			Error: errors.New("unauthorized"),
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			if actual := RequiresAuth(testCases[i].Error); testCases[i].Expected.RequiresAuth != actual {
+				t.Errorf("expected requires auth is %t, but got %t", testCases[i].Expected.RequiresAuth, actual)
+			}
+
+			if actual := RequiresPayment(testCases[i].Error); testCases[i].Expected.RequiresPayment != actual {
+				t.Errorf("expected requires payment is %t, but got %t", testCases[i].Expected.RequiresPayment, actual)
+			}
+		})
+	}
+}
@@ -1,13 +1,377 @@
 package gocerr
 
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
 type ErrorField struct {
 	Field   string
+	Code    string
 	Message string
+	// MessageKey, when set, identifies the message for a Localizer.
+	MessageKey string
 }
 
+// F is a compact field and message pair accepted by NewF.
+type F = struct{ Field, Message string }
+
 func NewErrorField(field string, message string) ErrorField {
 	return ErrorField{
 		Field:   field,
 		Message: message,
 	}
 }
+
+// NewErrorFieldWithCode is like NewErrorField but also sets a machine-readable
+// code (such as "required" or "too_long") so clients can localize the message.
+func NewErrorFieldWithCode(field string, code string, message string) ErrorField {
+	return ErrorField{
+		Field:   field,
+		Code:    code,
+		Message: message,
+	}
+}
+
+// FieldsFromStructJSON calls validate for every exported field of the struct
+// v (or the struct v points to) and returns an error field for each non-empty
+// message it reports. Fields are named after their JSON tag, with options such
+// as omitempty stripped and the Go name used when the tag has no name; fields
+// tagged "-" are skipped. Untagged embedded structs, exported or not, and
+// non-nil pointers to exported ones are flattened like encoding/json does.
+// It returns nil when v is not a struct or nothing fails.
+func FieldsFromStructJSON(v any, validate func(fieldName string, value any) string) []ErrorField {
+	var value reflect.Value = reflect.ValueOf(v)
+
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	return appendStructJSONFields(nil, value, validate)
+}
+
+func appendStructJSONFields(errorFields []ErrorField, value reflect.Value, validate func(fieldName string, value any) string) []ErrorField {
+	var (
+		structType  reflect.Type = value.Type()
+		structField reflect.StructField
+		fieldValue  reflect.Value
+		name        string
+		message     string
+	)
+
+	for i := 0; i < structType.NumField(); i++ {
+		structField = structType.Field(i)
+		fieldValue = value.Field(i)
+
+		name, _, _ = strings.Cut(structField.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if structField.Anonymous && name == "" {
+			if fieldValue.Kind() == reflect.Pointer && structField.IsExported() {
+				if fieldValue.IsNil() {
+					continue
+				}
+				fieldValue = fieldValue.Elem()
+			}
+			if fieldValue.Kind() == reflect.Struct {
+				errorFields = appendStructJSONFields(errorFields, fieldValue, validate)
+				continue
+			}
+		}
+
+		if !structField.IsExported() || !fieldValue.CanInterface() {
+			continue
+		}
+
+		if name == "" {
+			name = structField.Name
+		}
+
+		message = validate(name, fieldValue.Interface())
+		if message != "" {
+			errorFields = append(errorFields, NewErrorField(name, message))
+		}
+	}
+
+	return errorFields
+}
+
+// ConflictingFields returns, for every field name of err that has more than
+// one distinct message, those distinct messages in order of appearance. An
+// empty map means there are no conflicts.
+func ConflictingFields(err error) map[string][]string {
+	var (
+		customError Error
+		messages    map[string][]string
+		seen        map[[2]string]bool
+		conflicts   map[string][]string
+	)
+
+	customError, _ = Parse(err)
+	messages = make(map[string][]string)
+	seen = make(map[[2]string]bool, len(customError.ErrorFields))
+	conflicts = make(map[string][]string)
+
+	for i := 0; i < len(customError.ErrorFields); i++ {
+		if seen[[2]string{customError.ErrorFields[i].Field, customError.ErrorFields[i].Message}] {
+			continue
+		}
+		seen[[2]string{customError.ErrorFields[i].Field, customError.ErrorFields[i].Message}] = true
+		messages[customError.ErrorFields[i].Field] = append(messages[customError.ErrorFields[i].Field], customError.ErrorFields[i].Message)
+	}
+
+	for field, fieldMessages := range messages {
+		if len(fieldMessages) > 1 {
+			conflicts[field] = fieldMessages
+		}
+	}
+
+	return conflicts
+}
+
+// Error makes ErrorField usable as an error, rendering it like String.
+func (ef ErrorField) Error() string {
+	return ef.String()
+}
+
+// FieldsAsErrors returns the error fields of err as a slice of errors, each
+// element being the ErrorField itself. It returns nil when err is not a
+// custom error or has no fields.
+func FieldsAsErrors(err error) []error {
+	var (
+		customError Error
+		errs        []error
+	)
+
+	customError, _ = Parse(err)
+	for i := 0; i < len(customError.ErrorFields); i++ {
+		errs = append(errs, customError.ErrorFields[i])
+	}
+
+	return errs
+}
+
+func findErrorField(err error, match func(field string) bool) (ErrorField, bool) {
+	var customError Error
+
+	customError, _ = Parse(err)
+	for i := 0; i < len(customError.ErrorFields); i++ {
+		if match(customError.ErrorFields[i].Field) {
+			return customError.ErrorFields[i], true
+		}
+	}
+
+	return ErrorField{}, false
+}
+
+func HasErrorField(err error, fieldName string) bool {
+	var found bool
+
+	_, found = findErrorField(err, func(field string) bool {
+		return field == fieldName
+	})
+
+	return found
+}
+
+// GetErrorFieldMessage returns the message of the first error field named
+// fieldName, or an empty string when there is none.
+func GetErrorFieldMessage(err error, fieldName string) string {
+	var errorField ErrorField
+
+	errorField, _ = findErrorField(err, func(field string) bool {
+		return field == fieldName
+	})
+
+	return errorField.Message
+}
+
+// GetErrorFieldMessages returns every message registered under fieldName in
+// insertion order, or nil when err is not a custom error or has no such field.
+func GetErrorFieldMessages(err error, fieldName string) []string {
+	var (
+		customError Error
+		messages    []string
+	)
+
+	customError, _ = Parse(err)
+	for i := 0; i < len(customError.ErrorFields); i++ {
+		if customError.ErrorFields[i].Field == fieldName {
+			messages = append(messages, customError.ErrorFields[i].Message)
+		}
+	}
+
+	return messages
+}
+
+// FilterErrorFields returns a copy of the error fields of err for which keep
+// returns true, or nil when err is not a custom error or nothing is kept.
+func FilterErrorFields(err error, keep func(ErrorField) bool) []ErrorField {
+	var (
+		customError Error
+		errorFields []ErrorField
+	)
+
+	customError, _ = Parse(err)
+	for i := 0; i < len(customError.ErrorFields); i++ {
+		if keep(customError.ErrorFields[i]) {
+			errorFields = append(errorFields, customError.ErrorFields[i])
+		}
+	}
+
+	return errorFields
+}
+
+// GetErrorFieldsByPrefix returns a copy of the error fields of err that lie
+// under the path prefix: the field equals prefix or continues it with a "."
+// or "[" separator, so "user.address" matches "user.address.city" and
+// "items" matches "items[0]", but "user.addressee" is not matched. It returns
+// nil when err is not a custom error or nothing matches.
+func GetErrorFieldsByPrefix(err error, prefix string) []ErrorField {
+	return FilterErrorFields(err, func(errorField ErrorField) bool {
+		var rest string
+
+		if !strings.HasPrefix(errorField.Field, prefix) {
+			return false
+		}
+
+		rest = errorField.Field[len(prefix):]
+
+		return rest == "" || rest[0] == '.' || rest[0] == '['
+	})
+}
+
+// GetErrorFieldMap returns the error fields of err keyed by field name. When a
+// field name repeats, the last message wins. It returns nil when err is not a
+// custom error.
+func GetErrorFieldMap(err error) map[string]string {
+	var (
+		customError   Error
+		isCustomError bool
+		fieldMap      map[string]string
+	)
+
+	customError, isCustomError = Parse(err)
+	if !isCustomError {
+		return nil
+	}
+
+	fieldMap = make(map[string]string, len(customError.ErrorFields))
+	for i := 0; i < len(customError.ErrorFields); i++ {
+		fieldMap[customError.ErrorFields[i].Field] = customError.ErrorFields[i].Message
+	}
+
+	return fieldMap
+}
+
+// HasErrorFieldFold is like HasErrorField but compares field names with
+// strings.EqualFold.
+func HasErrorFieldFold(err error, fieldName string) bool {
+	var found bool
+
+	_, found = findErrorField(err, func(field string) bool {
+		return strings.EqualFold(field, fieldName)
+	})
+
+	return found
+}
+
+// GetErrorFieldMessageFold is like GetErrorFieldMessage but compares field
+// names with strings.EqualFold.
+func GetErrorFieldMessageFold(err error, fieldName string) string {
+	var errorField ErrorField
+
+	errorField, _ = findErrorField(err, func(field string) bool {
+		return strings.EqualFold(field, fieldName)
+	})
+
+	return errorField.Message
+}
+
+func sortedErrorFields(errorFields []ErrorField) []ErrorField {
+	var sorted []ErrorField = make([]ErrorField, len(errorFields))
+
+	copy(sorted, errorFields)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Field != sorted[j].Field {
+			return sorted[i].Field < sorted[j].Field
+		}
+		if sorted[i].Message != sorted[j].Message {
+			return sorted[i].Message < sorted[j].Message
+		}
+		if sorted[i].Code != sorted[j].Code {
+			return sorted[i].Code < sorted[j].Code
+		}
+		return sorted[i].MessageKey < sorted[j].MessageKey
+	})
+
+	return sorted
+}
+
+// GetErrorFields returns a copy of the error fields of err, or nil when err is
+// not a custom error. Callers may modify the returned slice freely.
+func GetErrorFields(err error) []ErrorField {
+	var (
+		customError   Error
+		isCustomError bool
+		errorFields   []ErrorField
+	)
+
+	customError, isCustomError = Parse(err)
+	if !isCustomError {
+		return nil
+	}
+
+	errorFields = make([]ErrorField, len(customError.ErrorFields))
+	copy(errorFields, customError.ErrorFields)
+
+	return errorFields
+}
+
+// ErrorFieldCount returns the number of error fields of err, duplicates
+// included, or 0 when err is not a custom error.
+func ErrorFieldCount(err error) int {
+	var (
+		customError   Error
+		isCustomError bool
+	)
+
+	customError, isCustomError = Parse(err)
+	if !isCustomError {
+		return 0
+	}
+
+	return len(customError.ErrorFields)
+}
+
+// DistinctFieldCount returns the number of unique field names among the error
+// fields of err, or 0 when err is not a custom error.
+func DistinctFieldCount(err error) int {
+	var (
+		customError   Error
+		isCustomError bool
+		fields        map[string]bool
+	)
+
+	customError, isCustomError = Parse(err)
+	if !isCustomError {
+		return 0
+	}
+
+	fields = make(map[string]bool, len(customError.ErrorFields))
+	for i := 0; i < len(customError.ErrorFields); i++ {
+		fields[customError.ErrorFields[i].Field] = true
+	}
+
+	return len(fields)
+}
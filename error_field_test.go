@@ -1,6 +1,11 @@
 package gocerr
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
 
 func TestNewErrorField(t *testing.T) {
 	field := "field1"
@@ -15,4 +20,725 @@ func TestNewErrorField(t *testing.T) {
 	if errField.Message != message {
 		t.Errorf("expected message is %s, but got %s", message, errField.Message)
 	}
+
+	if errField.Code != "" {
+		t.Errorf("expected code is empty, but got %s", errField.Code)
+	}
+}
+
+func TestNewErrorFieldWithCode(t *testing.T) {
+	var errField ErrorField = NewErrorFieldWithCode("field1", "required", "field is required")
+
+	if errField.Field != "field1" {
+		t.Errorf("expected field is %s, but got %s", "field1", errField.Field)
+	}
+
+	if errField.Code != "required" {
+		t.Errorf("expected code is %s, but got %s", "required", errField.Code)
+	}
+
+	if errField.Message != "field is required" {
+		t.Errorf("expected message is %s, but got %s", "field is required", errField.Message)
+	}
+}
+
+type Audit struct {
+	CreatedBy string `json:"created_by"`
+}
+
+type signupRequest struct {
+	Audit
+	Email    string `json:"email"`
+	Nickname string `json:"nickname,omitempty"`
+	Age      int
+	Password string `json:"-"`
+}
+
+type base struct {
+	ID     string `json:"id"`
+	secret string
+}
+
+type profileRequest struct {
+	base
+	*Audit
+	Name string `json:"name"`
+}
+
+func TestFieldsFromStructJSON(t *testing.T) {
+	var validate func(fieldName string, value any) string = func(fieldName string, value any) string {
+		if value == "" || value == 0 {
+			return fieldName + " is required"
+		}
+		return ""
+	}
+
+	testCases := []struct {
+		Name     string
+		Value    any
+		Expected []ErrorField
+	}{
+		{
+			Name:     "value is not struct",
+			Value:    "some value",
+			Expected: nil,
+		},
+		{
+			Name: "every field is valid",
+			Value: signupRequest{
+				Audit:    Audit{CreatedBy: "admin"},
+				Email:    "john@example.com",
+				Nickname: "john",
+				Age:      20,
+			},
+			Expected: nil,
+		},
+		{
+			Name:  "tagged struct with embedded struct",
+			Value: &signupRequest{Email: "john@example.com"},
+			Expected: []ErrorField{
+				NewErrorField("created_by", "created_by is required"),
+				NewErrorField("nickname", "nickname is required"),
+				NewErrorField("Age", "Age is required"),
+			},
+		},
+		{
+			Name:  "unexported embedded struct",
+			Value: profileRequest{Name: "john"},
+			Expected: []ErrorField{
+				NewErrorField("id", "id is required"),
+			},
+		},
+		{
+			Name:  "embedded pointer to struct",
+			Value: profileRequest{base: base{ID: "1"}, Audit: &Audit{}},
+			Expected: []ErrorField{
+				NewErrorField("created_by", "created_by is required"),
+				NewErrorField("name", "name is required"),
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []ErrorField = FieldsFromStructJSON(testCases[i].Value, validate)
+
+			if testCases[i].Expected == nil && actual != nil {
+				t.Fatalf("expected error fields is nil, but got %v", actual)
+			}
+
+			if len(testCases[i].Expected) != len(actual) {
+				t.Fatalf("expected length of error fields is %d, but got %d", len(testCases[i].Expected), len(actual))
+			}
+
+			for j := 0; j < len(testCases[i].Expected); j++ {
+				if testCases[i].Expected[j] != actual[j] {
+					t.Errorf("expected error field is %v, but got %v", testCases[i].Expected[j], actual[j])
+				}
+			}
+		})
+	}
+}
+
+func TestConflictingFields(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected map[string][]string
+	}{
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Expected: map[string][]string{},
+		},
+		{
+			Name: "no conflicts",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field2", "min value is 50"),
+			),
+			Expected: map[string][]string{},
+		},
+		{
+			Name: "field with two different messages",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field2", "min value is 50"),
+				NewErrorField("field1", "must be empty"),
+				NewErrorField("field1", "field is required"),
+			),
+			Expected: map[string][]string{
+				"field1": {"field is required", "must be empty"},
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual map[string][]string = ConflictingFields(testCases[i].Error)
+
+			if actual == nil {
+				t.Fatalf("expected conflicts is not nil")
+			}
+
+			if len(testCases[i].Expected) != len(actual) {
+				t.Fatalf("expected length of conflicts is %d, but got %d", len(testCases[i].Expected), len(actual))
+			}
+
+			for field, expectedMessages := range testCases[i].Expected {
+				if len(expectedMessages) != len(actual[field]) {
+					t.Fatalf("expected length of %s messages is %d, but got %d", field, len(expectedMessages), len(actual[field]))
+				}
+				for j := 0; j < len(expectedMessages); j++ {
+					if expectedMessages[j] != actual[field][j] {
+						t.Errorf("expected message of %s is %s, but got %s", field, expectedMessages[j], actual[field][j])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGetErrorFields(t *testing.T) {
+	var (
+		customError Error = New(400, "bad request", NewErrorField("field1", "field is required"))
+		actual      []ErrorField
+	)
+
+	if GetErrorFields(errors.New("some error")) != nil {
+		t.Errorf("expected error fields of non custom error is nil")
+	}
+
+	actual = GetErrorFields(customError)
+	if len(actual) != 1 || actual[0] != customError.ErrorFields[0] {
+		t.Fatalf("expected error fields is %v, but got %v", customError.ErrorFields, actual)
+	}
+
+	actual[0].Message = "changed"
+	if customError.ErrorFields[0].Message != "field is required" {
+		t.Errorf("expected original error field is unchanged, but got %s", customError.ErrorFields[0].Message)
+	}
+}
+
+func TestErrorFieldCount(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected struct {
+			ErrorFieldCount    int
+			DistinctFieldCount int
+		}
+	}{
+		{
+			Name:  "error is not custom error",
+			Error: errors.New("some error"),
+			Expected: struct {
+				ErrorFieldCount    int
+				DistinctFieldCount int
+			}{
+				ErrorFieldCount:    0,
+				DistinctFieldCount: 0,
+			},
+		},
+		{
+			Name: "error with duplicate field names",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("email", "field is required"),
+				NewErrorField("email", "invalid format"),
+				NewErrorField("age", "min value is 18"),
+			),
+			Expected: struct {
+				ErrorFieldCount    int
+				DistinctFieldCount int
+			}{
+				ErrorFieldCount:    3,
+				DistinctFieldCount: 2,
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualCount    int = ErrorFieldCount(testCases[i].Error)
+				actualDistinct int = DistinctFieldCount(testCases[i].Error)
+			)
+
+			if testCases[i].Expected.ErrorFieldCount != actualCount {
+				t.Errorf("expected error field count is %d, but got %d", testCases[i].Expected.ErrorFieldCount, actualCount)
+			}
+
+			if testCases[i].Expected.DistinctFieldCount != actualDistinct {
+				t.Errorf("expected distinct field count is %d, but got %d", testCases[i].Expected.DistinctFieldCount, actualDistinct)
+			}
+		})
+	}
+}
+
+func BenchmarkGetErrorFields(b *testing.B) {
+	var customError Error = New(
+		400,
+		"bad request",
+		NewErrorField("field1", "field is required"),
+		NewErrorField("field2", "min value is 50"),
+		NewErrorField("field3", "invalid format"),
+	)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = GetErrorFields(customError)
+	}
+}
+
+func BenchmarkError_FieldsRef(b *testing.B) {
+	var customError Error = New(
+		400,
+		"bad request",
+		NewErrorField("field1", "field is required"),
+		NewErrorField("field2", "min value is 50"),
+		NewErrorField("field3", "invalid format"),
+	)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = customError.FieldsRef()
+	}
+}
+
+// FieldsRef shares its slice with the error, so writing through it changes the
+// error itself (and every copy of it); GetErrorFields does not.
+func ExampleError_FieldsRef() {
+	var (
+		original Error        = New(400, "bad request", NewErrorField("email", "field is required"))
+		copied   []ErrorField = GetErrorFields(original)
+		shared   []ErrorField = original.FieldsRef()
+	)
+
+	copied[0].Message = "changed through the copy"
+	fmt.Println(original.ErrorFields[0].Message)
+
+	shared[0].Message = "changed through the ref"
+	fmt.Println(original.ErrorFields[0].Message)
+
+	// Output:
+	// field is required
+	// changed through the ref
+}
+
+func TestFieldsAsErrors(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected []string
+	}{
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Expected: nil,
+		},
+		{
+			Name: "multiple error fields",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field2", "min value is 50"),
+			),
+			Expected: []string{"field1: field is required", "field2: min value is 50"},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []error = FieldsAsErrors(testCases[i].Error)
+
+			if len(testCases[i].Expected) != len(actual) {
+				t.Fatalf("expected length of errors is %d, but got %d", len(testCases[i].Expected), len(actual))
+			}
+
+			for j := 0; j < len(testCases[i].Expected); j++ {
+				if testCases[i].Expected[j] != actual[j].Error() {
+					t.Errorf("expected error message is %s, but got %s", testCases[i].Expected[j], actual[j].Error())
+				}
+
+				if _, isErrorField := actual[j].(ErrorField); !isErrorField {
+					t.Errorf("expected error is ErrorField, but got %T", actual[j])
+				}
+			}
+		})
+	}
+}
+
+func TestErrorFieldLookup(t *testing.T) {
+	var customError Error = New(
+		400,
+		"bad request",
+		NewErrorField("email", "email is invalid"),
+		NewErrorField("ΣΟΦΙΑ", "field is required"),
+		NewErrorField("email", "email is required"),
+	)
+
+	testCases := []struct {
+		Name     string
+		Error    error
+		Field    string
+		Expected struct {
+			Has         bool
+			Message     string
+			HasFold     bool
+			MessageFold string
+		}
+	}{
+		{
+			Name:  "error is not custom error",
+			Error: errors.New("some error"),
+			Field: "email",
+		},
+		{
+			Name:  "exact match returns first message",
+			Error: customError,
+			Field: "email",
+			Expected: struct {
+				Has         bool
+				Message     string
+				HasFold     bool
+				MessageFold string
+			}{
+				Has:         true,
+				Message:     "email is invalid",
+				HasFold:     true,
+				MessageFold: "email is invalid",
+			},
+		},
+		{
+			Name:  "different case",
+			Error: customError,
+			Field: "Email",
+			Expected: struct {
+				Has         bool
+				Message     string
+				HasFold     bool
+				MessageFold string
+			}{
+				HasFold:     true,
+				MessageFold: "email is invalid",
+			},
+		},
+		{
+			Name:  "unicode folding",
+			Error: customError,
+			Field: "σοφια",
+			Expected: struct {
+				Has         bool
+				Message     string
+				HasFold     bool
+				MessageFold string
+			}{
+				HasFold:     true,
+				MessageFold: "field is required",
+			},
+		},
+		{
+			Name:  "unknown field",
+			Error: customError,
+			Field: "name",
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			if actual := HasErrorField(testCases[i].Error, testCases[i].Field); testCases[i].Expected.Has != actual {
+				t.Errorf("expected has error field is %t, but got %t", testCases[i].Expected.Has, actual)
+			}
+
+			if actual := GetErrorFieldMessage(testCases[i].Error, testCases[i].Field); testCases[i].Expected.Message != actual {
+				t.Errorf("expected error field message is %s, but got %s", testCases[i].Expected.Message, actual)
+			}
+
+			if actual := HasErrorFieldFold(testCases[i].Error, testCases[i].Field); testCases[i].Expected.HasFold != actual {
+				t.Errorf("expected has error field fold is %t, but got %t", testCases[i].Expected.HasFold, actual)
+			}
+
+			if actual := GetErrorFieldMessageFold(testCases[i].Error, testCases[i].Field); testCases[i].Expected.MessageFold != actual {
+				t.Errorf("expected error field message fold is %s, but got %s", testCases[i].Expected.MessageFold, actual)
+			}
+		})
+	}
+}
+
+func TestGetErrorFieldMessages(t *testing.T) {
+	var customError Error = New(
+		400,
+		"bad request",
+		NewErrorField("password", "field is required"),
+		NewErrorField("email", "email is invalid"),
+		NewErrorField("password", "min length is 8"),
+		NewErrorField("password", "must contain a digit"),
+	)
+
+	testCases := []struct {
+		Name     string
+		Error    error
+		Field    string
+		Expected []string
+	}{
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Field:    "password",
+			Expected: nil,
+		},
+		{
+			Name:     "unknown field",
+			Error:    customError,
+			Field:    "name",
+			Expected: nil,
+		},
+		{
+			Name:     "field repeated three times",
+			Error:    customError,
+			Field:    "password",
+			Expected: []string{"field is required", "min length is 8", "must contain a digit"},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []string = GetErrorFieldMessages(testCases[i].Error, testCases[i].Field)
+
+			if testCases[i].Expected == nil && actual != nil {
+				t.Fatalf("expected messages is nil, but got %v", actual)
+			}
+
+			if len(testCases[i].Expected) != len(actual) {
+				t.Fatalf("expected length of messages is %d, but got %d", len(testCases[i].Expected), len(actual))
+			}
+
+			for j := 0; j < len(testCases[i].Expected); j++ {
+				if testCases[i].Expected[j] != actual[j] {
+					t.Errorf("expected message is %s, but got %s", testCases[i].Expected[j], actual[j])
+				}
+			}
+		})
+	}
+}
+
+func TestFilterErrorFields(t *testing.T) {
+	var customError Error = New(
+		400,
+		"bad request",
+		NewErrorField("address.city", "field is required"),
+		NewErrorField("email", "email is invalid"),
+		NewErrorField("address.zip", "zip is invalid"),
+	)
+
+	testCases := []struct {
+		Name     string
+		Error    error
+		Keep     func(ErrorField) bool
+		Expected []ErrorField
+	}{
+		{
+			Name:  "error is not custom error",
+			Error: errors.New("some error"),
+			Keep: func(errorField ErrorField) bool {
+				return true
+			},
+			Expected: nil,
+		},
+		{
+			Name:  "filter by field name prefix",
+			Error: customError,
+			Keep: func(errorField ErrorField) bool {
+				return strings.HasPrefix(errorField.Field, "address.")
+			},
+			Expected: []ErrorField{
+				NewErrorField("address.city", "field is required"),
+				NewErrorField("address.zip", "zip is invalid"),
+			},
+		},
+		{
+			Name:  "filter by message content",
+			Error: customError,
+			Keep: func(errorField ErrorField) bool {
+				return strings.Contains(errorField.Message, "invalid")
+			},
+			Expected: []ErrorField{
+				NewErrorField("email", "email is invalid"),
+				NewErrorField("address.zip", "zip is invalid"),
+			},
+		},
+		{
+			Name:  "nothing matches",
+			Error: customError,
+			Keep: func(errorField ErrorField) bool {
+				return errorField.Field == "name"
+			},
+			Expected: nil,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []ErrorField = FilterErrorFields(testCases[i].Error, testCases[i].Keep)
+
+			if testCases[i].Expected == nil && actual != nil {
+				t.Fatalf("expected error fields is nil, but got %v", actual)
+			}
+
+			if len(testCases[i].Expected) != len(actual) {
+				t.Fatalf("expected length of error fields is %d, but got %d", len(testCases[i].Expected), len(actual))
+			}
+
+			for j := 0; j < len(testCases[i].Expected); j++ {
+				if testCases[i].Expected[j] != actual[j] {
+					t.Errorf("expected error field is %v, but got %v", testCases[i].Expected[j], actual[j])
+				}
+			}
+		})
+	}
+}
+
+func TestGetErrorFieldsByPrefix(t *testing.T) {
+	var customError Error = New(
+		400,
+		"bad request",
+		NewErrorField("user.address.city", "field is required"),
+		NewErrorField("user.addressee", "field is required"),
+		NewErrorField("user.address", "field is required"),
+		NewErrorField("items[0].name", "field is required"),
+		NewErrorField("items[1]", "invalid item"),
+		NewErrorField("itemsCount", "min value is 1"),
+	)
+
+	testCases := []struct {
+		Name     string
+		Error    error
+		Prefix   string
+		Expected []ErrorField
+	}{
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Prefix:   "user",
+			Expected: nil,
+		},
+		{
+			Name:   "nested dotted path",
+			Error:  customError,
+			Prefix: "user.address",
+			Expected: []ErrorField{
+				NewErrorField("user.address.city", "field is required"),
+				NewErrorField("user.address", "field is required"),
+			},
+		},
+		{
+			Name:   "array index notation",
+			Error:  customError,
+			Prefix: "items",
+			Expected: []ErrorField{
+				NewErrorField("items[0].name", "field is required"),
+				NewErrorField("items[1]", "invalid item"),
+			},
+		},
+		{
+			Name:   "array element",
+			Error:  customError,
+			Prefix: "items[0]",
+			Expected: []ErrorField{
+				NewErrorField("items[0].name", "field is required"),
+			},
+		},
+		{
+			Name:     "nothing matches",
+			Error:    customError,
+			Prefix:   "order",
+			Expected: nil,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []ErrorField = GetErrorFieldsByPrefix(testCases[i].Error, testCases[i].Prefix)
+
+			if testCases[i].Expected == nil && actual != nil {
+				t.Fatalf("expected error fields is nil, but got %v", actual)
+			}
+
+			if len(testCases[i].Expected) != len(actual) {
+				t.Fatalf("expected length of error fields is %d, but got %d", len(testCases[i].Expected), len(actual))
+			}
+
+			for j := 0; j < len(testCases[i].Expected); j++ {
+				if testCases[i].Expected[j] != actual[j] {
+					t.Errorf("expected error field is %v, but got %v", testCases[i].Expected[j], actual[j])
+				}
+			}
+		})
+	}
+}
+
+func TestGetErrorFieldMap(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected map[string]string
+	}{
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Expected: nil,
+		},
+		{
+			Name:     "no error fields",
+			Error:    New(500, "internal server error"),
+			Expected: map[string]string{},
+		},
+		{
+			Name:     "single error field",
+			Error:    New(400, "bad request", NewErrorField("field1", "field is required")),
+			Expected: map[string]string{"field1": "field is required"},
+		},
+		{
+			Name: "duplicate error field keeps last message",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field2", "min value is 50"),
+				NewErrorField("field1", "invalid format"),
+			),
+			Expected: map[string]string{
+				"field1": "invalid format",
+				"field2": "min value is 50",
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual map[string]string = GetErrorFieldMap(testCases[i].Error)
+
+			if (testCases[i].Expected == nil) != (actual == nil) {
+				t.Fatalf("expected field map is %v, but got %v", testCases[i].Expected, actual)
+			}
+
+			if len(testCases[i].Expected) != len(actual) {
+				t.Fatalf("expected length of field map is %d, but got %d", len(testCases[i].Expected), len(actual))
+			}
+
+			for field, message := range testCases[i].Expected {
+				if message != actual[field] {
+					t.Errorf("expected message of %s is %s, but got %s", field, message, actual[field])
+				}
+			}
+		})
+	}
 }
@@ -0,0 +1,321 @@
+package gocerr
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Localizer translates a message key, with optional arguments, into a message.
+type Localizer interface {
+	Localize(key string, args ...any) string
+}
+
+// StringFieldSeparator separates the error fields in the output of String.
+var StringFieldSeparator string = ", "
+
+// String renders ef as "field: message", or "field (code): message" when ef
+// has a code.
+func (ef ErrorField) String() string {
+	if ef.Code != "" {
+		return ef.Field + " (" + ef.Code + "): " + ef.Message
+	}
+
+	return ef.Field + ": " + ef.Message
+}
+
+func (e Error) writeHeader(builder *strings.Builder) {
+	var codeName string = CodeName(e.Code)
+
+	builder.WriteString("[")
+	builder.WriteString(strconv.Itoa(e.Code))
+	if codeName != "" {
+		builder.WriteString(" ")
+		builder.WriteString(codeName)
+	}
+	builder.WriteString("] ")
+	builder.WriteString(e.Message)
+}
+
+// String renders e as "[code] message {field: message, ...}", joining the
+// error fields with StringFieldSeparator and appending " (trace_id: id)",
+// " (at: timestamp)" and " caused by: cause" when e carries them. A name
+// registered for the code with RegisterCode is shown next to it, as in
+// "[400 BadRequest]".
+func (e Error) String() string {
+	var builder strings.Builder
+
+	e.writeHeader(&builder)
+
+	if len(e.ErrorFields) > 0 {
+		builder.WriteString(" {")
+		for i := 0; i < len(e.ErrorFields); i++ {
+			if i > 0 {
+				builder.WriteString(StringFieldSeparator)
+			}
+			builder.WriteString(e.ErrorFields[i].String())
+		}
+		builder.WriteString("}")
+	}
+
+	if e.TraceID != "" {
+		builder.WriteString(" (trace_id: ")
+		builder.WriteString(e.TraceID)
+		builder.WriteString(")")
+	}
+
+	if !e.Timestamp.IsZero() {
+		builder.WriteString(" (at: ")
+		builder.WriteString(e.Timestamp.Format(time.RFC3339Nano))
+		builder.WriteString(")")
+	}
+
+	if e.Cause != nil {
+		builder.WriteString(" caused by: ")
+		builder.WriteString(e.Cause.Error())
+	}
+
+	return builder.String()
+}
+
+// LocalizedString is like String but renders the message of e and of its
+// error fields through l when they have a MessageKey. Messages without a key,
+// or whose key l translates to "", are rendered as is.
+func (e Error) LocalizedString(l Localizer) string {
+	var localized Error = e
+
+	localized.Message = localizeMessage(l, e.MessageKey, e.Message)
+
+	localized.ErrorFields = make([]ErrorField, len(e.ErrorFields))
+	copy(localized.ErrorFields, e.ErrorFields)
+
+	for i := 0; i < len(localized.ErrorFields); i++ {
+		localized.ErrorFields[i].Message = localizeMessage(l, localized.ErrorFields[i].MessageKey, localized.ErrorFields[i].Message)
+	}
+
+	return localized.String()
+}
+
+func localizeMessage(l Localizer, key string, message string) string {
+	var translated string
+
+	if key == "" {
+		return message
+	}
+
+	translated = l.Localize(key)
+	if translated == "" {
+		return message
+	}
+
+	return translated
+}
+
+func (e Error) verboseString() string {
+	var builder strings.Builder
+
+	e.writeHeader(&builder)
+
+	for i := 0; i < len(e.ErrorFields); i++ {
+		builder.WriteString("\n    ")
+		builder.WriteString(e.ErrorFields[i].String())
+	}
+
+	if e.TraceID != "" {
+		builder.WriteString("\ntrace_id: ")
+		builder.WriteString(e.TraceID)
+	}
+
+	if !e.Timestamp.IsZero() {
+		builder.WriteString("\nat: ")
+		builder.WriteString(e.Timestamp.Format(time.RFC3339Nano))
+	}
+
+	if e.Cause != nil {
+		builder.WriteString("\ncaused by: ")
+		builder.WriteString(e.Cause.Error())
+	}
+
+	return builder.String()
+}
+
+// Format implements fmt.Formatter. %+v prints a detailed multi-line form with
+// one error field per line, followed by the trace ID, timestamp and cause when
+// present. Every other verb formats the message as a string, keeping its flags
+// and width, so %v, %s, %q, %x and %-8s behave as they do for Error().
+func (e Error) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('+') {
+		_, _ = io.WriteString(f, e.verboseString())
+		return
+	}
+
+	_, _ = fmt.Fprintf(f, fmt.FormatString(f, verb), e.Message)
+}
+
+var markdownEscaper *strings.Replacer = strings.NewReplacer(
+	"\\", "\\\\",
+	"`", "\\`",
+	"*", "\\*",
+	"_", "\\_",
+	"[", "\\[",
+	"]", "\\]",
+	"(", "\\(",
+	")", "\\)",
+	"<", "\\<",
+	">", "\\>",
+	"#", "\\#",
+	"|", "\\|",
+	"!", "\\!",
+	"~", "\\~",
+	"\r\n", " ",
+	"\n", " ",
+)
+
+// ToMarkdown renders e as a Markdown heading with the code and message
+// followed by a bulleted list of the error fields. Markdown special
+// characters in field names and messages are escaped.
+func (e Error) ToMarkdown() string {
+	var builder strings.Builder
+
+	builder.WriteString("## ")
+	builder.WriteString(strconv.Itoa(e.Code))
+	builder.WriteString(" ")
+	builder.WriteString(markdownEscaper.Replace(e.Message))
+	builder.WriteString("\n")
+
+	if len(e.ErrorFields) > 0 {
+		builder.WriteString("\n")
+	}
+
+	for i := 0; i < len(e.ErrorFields); i++ {
+		builder.WriteString("- **")
+		builder.WriteString(markdownEscaper.Replace(e.ErrorFields[i].Field))
+		builder.WriteString("**: ")
+		builder.WriteString(markdownEscaper.Replace(e.ErrorFields[i].Message))
+		builder.WriteString("\n")
+	}
+
+	return builder.String()
+}
+
+var htmlTemplate *template.Template = template.Must(template.New("error").Parse(
+	`<h2>{{.Message}}</h2>` +
+		`{{if .ErrorFields}}<ul>{{range .ErrorFields}}<li><strong>{{.Field}}</strong>: {{.Message}}</li>{{end}}</ul>{{end}}`,
+))
+
+// ToHTML renders e as an HTML heading with the message followed by a list of
+// the error fields, omitted when there are none. Field names and messages are
+// escaped by html/template, so the result is safe to embed in a page.
+func (e Error) ToHTML() template.HTML {
+	var (
+		builder strings.Builder
+		err     error
+	)
+
+	err = htmlTemplate.Execute(&builder, e)
+	if err != nil {
+		return ""
+	}
+
+	return template.HTML(builder.String())
+}
+
+// Golden renders e as a stable multiline text suitable for golden files. The
+// error fields are sorted by name, then message, and every string is quoted,
+// so the output does not depend on field insertion order.
+func (e Error) Golden() string {
+	var (
+		builder     strings.Builder
+		errorFields []ErrorField = sortedErrorFields(e.ErrorFields)
+	)
+
+	builder.WriteString("code: ")
+	builder.WriteString(strconv.Itoa(e.Code))
+	builder.WriteString("\nmessage: ")
+	builder.WriteString(strconv.Quote(e.Message))
+	builder.WriteString("\nerror_fields:\n")
+
+	for i := 0; i < len(errorFields); i++ {
+		builder.WriteString("  - ")
+		builder.WriteString(strconv.Quote(errorFields[i].Field))
+		builder.WriteString(": ")
+		builder.WriteString(strconv.Quote(errorFields[i].Message))
+		builder.WriteString("\n")
+	}
+
+	return builder.String()
+}
+
+// NumberedFields renders the error fields of e as a 1-indexed list, one
+// "n) field: message" entry per line, or an empty string when there are none.
+func (e Error) NumberedFields() string {
+	var builder strings.Builder
+
+	for i := 0; i < len(e.ErrorFields); i++ {
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+		builder.WriteString(strconv.Itoa(i + 1))
+		builder.WriteString(") ")
+		builder.WriteString(e.ErrorFields[i].String())
+	}
+
+	return builder.String()
+}
+
+var ansiEscapePattern *regexp.Regexp = regexp.MustCompile(`\x1b(?:\[[0-9;?]*[ -/]*[@-~]|\][^\x07\x1b]*(?:\x07|\x1b\\)|[@-Z\\-_])`)
+
+func cleanText(text string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, ansiEscapePattern.ReplaceAllString(text, ""))
+}
+
+// Clean returns a copy of e with ANSI escape sequences and other control
+// characters removed from the message and every field message.
+func (e Error) Clean() Error {
+	var cleaned Error = e
+
+	cleaned.Message = cleanText(e.Message)
+	cleaned.ErrorFields = make([]ErrorField, len(e.ErrorFields))
+	copy(cleaned.ErrorFields, e.ErrorFields)
+
+	for i := 0; i < len(cleaned.ErrorFields); i++ {
+		cleaned.ErrorFields[i].Message = cleanText(cleaned.ErrorFields[i].Message)
+	}
+
+	return cleaned
+}
+
+// SortKey returns an indexable key that sorts errors by code, then message:
+// the code zero-padded to 10 digits, a "|" and the message lowercased with
+// whitespace collapsed, e.g. "0000000404|user not found". Codes are expected
+// to be non-negative.
+func (e Error) SortKey() string {
+	return fmt.Sprintf("%010d|%s", e.Code, strings.Join(strings.Fields(strings.ToLower(e.Message)), " "))
+}
+
+// GroupKey returns a key shared by errors that differ only in their messages:
+// the code, a "|" and the distinct field names sorted and joined with ",", e.g.
+// "422|email,name". It returns "" when err is not a custom error.
+func GroupKey(err error) string {
+	var (
+		customError   Error
+		isCustomError bool
+	)
+
+	customError, isCustomError = Parse(err)
+	if !isCustomError {
+		return ""
+	}
+
+	return strconv.Itoa(customError.Code) + "|" + strings.Join(customError.SortedFieldNames(), ",")
+}
@@ -0,0 +1,559 @@
+package gocerr
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestErrorField_String(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		ErrorField ErrorField
+		Expected   string
+	}{
+		{
+			Name:       "without code",
+			ErrorField: NewErrorField("field1", "field is required"),
+			Expected:   "field1: field is required",
+		},
+		{
+			Name:       "with code",
+			ErrorField: NewErrorFieldWithCode("field1", "required", "field is required"),
+			Expected:   "field1 (required): field is required",
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = testCases[i].ErrorField.String()
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected string is %s, but got %s", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestError_String(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Error     Error
+		Separator string
+		Expected  string
+	}{
+		{
+			Name:      "no error fields",
+			Error:     New(500, "internal server error"),
+			Separator: ", ",
+			Expected:  "[500] internal server error",
+		},
+		{
+			Name: "default separator",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field2", "min value is 50"),
+			),
+			Separator: ", ",
+			Expected:  "[400] bad request {field1: field is required, field2: min value is 50}",
+		},
+		{
+			Name: "custom separator",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field2", "min value is 50"),
+				NewErrorField("field3", "invalid format"),
+			),
+			Separator: "; ",
+			Expected:  "[400] bad request {field1: field is required; field2: min value is 50; field3: invalid format}",
+		},
+		{
+			Name: "error fields with code",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorFieldWithCode("field1", "required", "field is required"),
+				NewErrorField("field2", "min value is 50"),
+			),
+			Separator: ", ",
+			Expected:  "[400] bad request {field1 (required): field is required, field2: min value is 50}",
+		},
+		{
+			Name: "with cause",
+			Error: Wrap(
+				500,
+				"internal server error",
+				errors.New("connection refused"),
+				NewErrorField("field1", "field is required"),
+			),
+			Separator: ", ",
+			Expected:  "[500] internal server error {field1: field is required} caused by: connection refused",
+		},
+		{
+			Name: "with trace id",
+			Error: Wrap(
+				500,
+				"internal server error",
+				errors.New("connection refused"),
+				NewErrorField("field1", "field is required"),
+			).WithTraceID("req-123"),
+			Separator: ", ",
+			Expected:  "[500] internal server error {field1: field is required} (trace_id: req-123) caused by: connection refused",
+		},
+		{
+			Name:      "with timestamp",
+			Error:     NewAt(500, "internal server error", time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC)),
+			Separator: ", ",
+			Expected:  "[500] internal server error (at: 2024-03-01T10:30:00Z)",
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				defaultSeparator string = StringFieldSeparator
+				actual           string
+			)
+
+			StringFieldSeparator = testCases[i].Separator
+			defer func() {
+				StringFieldSeparator = defaultSeparator
+			}()
+
+			actual = testCases[i].Error.String()
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected string is %s, but got %s", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+type mapLocalizer map[string]string
+
+func (l mapLocalizer) Localize(key string, args ...any) string {
+	return l[key]
+}
+
+func TestError_LocalizedString(t *testing.T) {
+	var localizer mapLocalizer = mapLocalizer{
+		"error.validation": "permintaan tidak valid",
+		"field.required":   "wajib diisi",
+	}
+
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Expected string
+	}{
+		{
+			Name:     "no message keys",
+			Error:    New(400, "bad request", NewErrorField("name", "field is required")),
+			Expected: "[400] bad request {name: field is required}",
+		},
+		{
+			Name: "message keys",
+			Error: Error{
+				Code:       400,
+				Message:    "bad request",
+				MessageKey: "error.validation",
+				ErrorFields: []ErrorField{
+					{Field: "name", Message: "field is required", MessageKey: "field.required"},
+					{Field: "age", Message: "min value is 18"},
+				},
+			},
+			Expected: "[400] permintaan tidak valid {name: wajib diisi, age: min value is 18}",
+		},
+		{
+			Name: "unknown message key",
+			Error: Error{
+				Code:       400,
+				Message:    "bad request",
+				MessageKey: "error.unknown",
+			},
+			Expected: "[400] bad request",
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = testCases[i].Error.LocalizedString(localizer)
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected string is %s, but got %s", testCases[i].Expected, actual)
+			}
+
+			if testCases[i].Error.ErrorFields != nil && testCases[i].Error.ErrorFields[0].Message != "field is required" {
+				t.Errorf("expected original error fields are unchanged, but got %v", testCases[i].Error.ErrorFields)
+			}
+		})
+	}
+}
+
+func TestError_ToMarkdown(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Expected string
+	}{
+		{
+			Name:     "no error fields",
+			Error:    New(500, "internal server error"),
+			Expected: "## 500 internal server error\n",
+		},
+		{
+			Name: "two error fields",
+			Error: New(
+				400,
+				"bad *request*",
+				NewErrorField("user_name", "field is required"),
+				NewErrorField("age", "must be <= 50 [years]"),
+			),
+			Expected: "## 400 bad \\*request\\*\n" +
+				"\n" +
+				"- **user\\_name**: field is required\n" +
+				"- **age**: must be \\<= 50 \\[years\\]\n",
+		},
+		{
+			Name:     "strikethrough",
+			Error:    New(409, "~~stale~~ version", NewErrorField("etag", "~1 revision behind")),
+			Expected: "## 409 \\~\\~stale\\~\\~ version\n\n- **etag**: \\~1 revision behind\n",
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = testCases[i].Error.ToMarkdown()
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected markdown is %q, but got %q", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestError_ToHTML(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Expected template.HTML
+	}{
+		{
+			Name:     "no error fields",
+			Error:    New(500, "internal server error"),
+			Expected: "<h2>internal server error</h2>",
+		},
+		{
+			Name: "two error fields",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("name", "field is required"),
+				NewErrorField("age", "must be <= 50"),
+			),
+			Expected: "<h2>bad request</h2><ul>" +
+				"<li><strong>name</strong>: field is required</li>" +
+				"<li><strong>age</strong>: must be &lt;= 50</li>" +
+				"</ul>",
+		},
+		{
+			Name: "script in messages",
+			Error: New(
+				400,
+				"<script>alert(1)</script>",
+				NewErrorField("<b>name</b>", "<script>alert(2)</script>"),
+			),
+			Expected: "<h2>&lt;script&gt;alert(1)&lt;/script&gt;</h2><ul>" +
+				"<li><strong>&lt;b&gt;name&lt;/b&gt;</strong>: &lt;script&gt;alert(2)&lt;/script&gt;</li>" +
+				"</ul>",
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual template.HTML = testCases[i].Error.ToHTML()
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected html is %q, but got %q", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestError_Golden(t *testing.T) {
+	var (
+		expected string
+		first    string
+		second   string
+	)
+
+	expected = "code: 400\n" +
+		"message: \"bad request\"\n" +
+		"error_fields:\n" +
+		"  - \"field1\": \"field is required\"\n" +
+		"  - \"field1\": \"invalid format\"\n" +
+		"  - \"field2\": \"min value is 50\"\n"
+
+	first = New(
+		400,
+		"bad request",
+		NewErrorField("field2", "min value is 50"),
+		NewErrorField("field1", "invalid format"),
+		NewErrorField("field1", "field is required"),
+	).Golden()
+	second = New(
+		400,
+		"bad request",
+		NewErrorField("field1", "field is required"),
+		NewErrorField("field1", "invalid format"),
+		NewErrorField("field2", "min value is 50"),
+	).Golden()
+
+	if first != second {
+		t.Errorf("expected identical golden output, but got %q and %q", first, second)
+	}
+
+	if expected != first {
+		t.Errorf("expected golden output is %q, but got %q", expected, first)
+	}
+}
+
+func TestError_NumberedFields(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Expected string
+	}{
+		{
+			Name:     "no error fields",
+			Error:    New(500, "internal server error"),
+			Expected: "",
+		},
+		{
+			Name: "multiple error fields",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("email", "invalid"),
+				NewErrorField("age", "too low"),
+			),
+			Expected: "1) email: invalid\n2) age: too low",
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = testCases[i].Error.NumberedFields()
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected numbered fields is %q, but got %q", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestError_Format(t *testing.T) {
+	var customError Error = Wrap(
+		400,
+		"bad request",
+		errors.New("decode body"),
+		NewErrorField("field1", "field is required"),
+		NewErrorField("field2", "min value is 50"),
+	)
+
+	testCases := []struct {
+		Name     string
+		Format   string
+		Expected string
+	}{
+		{
+			Name:     "v verb",
+			Format:   "%v",
+			Expected: "bad request",
+		},
+		{
+			Name:     "s verb",
+			Format:   "%s",
+			Expected: "bad request",
+		},
+		{
+			Name:     "q verb",
+			Format:   "%q",
+			Expected: `"bad request"`,
+		},
+		{
+			Name:   "plus v verb",
+			Format: "%+v",
+			Expected: "[400] bad request\n" +
+				"    field1: field is required\n" +
+				"    field2: min value is 50\n" +
+				"caused by: decode body",
+		},
+		{
+			Name:     "x verb",
+			Format:   "%x",
+			Expected: "6261642072657175657374",
+		},
+		{
+			Name:     "width",
+			Format:   "%14s",
+			Expected: "   bad request",
+		},
+		{
+			Name:     "minus flag and width",
+			Format:   "%-14v|",
+			Expected: "bad request   |",
+		},
+		{
+			Name:     "unsupported verb",
+			Format:   "%d",
+			Expected: "%!d(string=bad request)",
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = fmt.Sprintf(testCases[i].Format, customError)
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected formatted error is %q, but got %q", testCases[i].Expected, actual)
+			}
+		})
+	}
+
+	t.Run("plus v verb with trace id and timestamp", func(t *testing.T) {
+		var (
+			traced Error = customError.
+				WithTraceID("req-123").
+				WithTimestamp(time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC))
+			expected string = "[400] bad request\n" +
+				"    field1: field is required\n" +
+				"    field2: min value is 50\n" +
+				"trace_id: req-123\n" +
+				"at: 2024-03-01T10:30:00Z\n" +
+				"caused by: decode body"
+			actual string = fmt.Sprintf("%+v", traced)
+		)
+
+		if expected != actual {
+			t.Errorf("expected formatted error is %q, but got %q", expected, actual)
+		}
+	})
+}
+
+func TestError_Clean(t *testing.T) {
+	var (
+		original Error = New(
+			500,
+			"\x1b[1;31mbuild failed\x1b[0m\x07",
+			NewErrorField("step", "\x1b]0;title\x07\x1b[32mcompile\x1b[0m:\tfailed\r\n"),
+			NewErrorField("name", "plain message"),
+		)
+		expected Error = New(
+			500,
+			"build failed",
+			NewErrorField("step", "compile:failed"),
+			NewErrorField("name", "plain message"),
+		)
+		actual Error = original.Clean()
+	)
+
+	if !expected.EqualIgnoring(actual) {
+		t.Errorf("expected error is %q, but got %q", expected.String(), actual.String())
+	}
+
+	if original.ErrorFields[0].Message == actual.ErrorFields[0].Message {
+		t.Errorf("expected original error field is unchanged")
+	}
+}
+
+func TestError_SortKey(t *testing.T) {
+	var (
+		errs []Error = []Error{
+			New(500, "internal server error"),
+			New(404, "User  Not Found"),
+			New(40, "custom"),
+			New(404, "order not found"),
+		}
+		expected []string = []string{
+			"0000000040|custom",
+			"0000000404|order not found",
+			"0000000404|user not found",
+			"0000000500|internal server error",
+		}
+		actual []string
+	)
+
+	for i := 0; i < len(errs); i++ {
+		actual = append(actual, errs[i].SortKey())
+	}
+	sort.Strings(actual)
+
+	for i := 0; i < len(expected); i++ {
+		if expected[i] != actual[i] {
+			t.Errorf("expected sort key is %s, but got %s", expected[i], actual[i])
+		}
+	}
+}
+
+func TestGroupKey(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected string
+	}{
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Expected: "",
+		},
+		{
+			Name: "error with error fields",
+			Error: New(
+				422,
+				"user john is invalid",
+				NewErrorField("name", "john is too short"),
+				NewErrorField("email", "john@ is invalid"),
+			),
+			Expected: "422|email,name",
+		},
+		{
+			Name: "same fields with different messages",
+			Error: New(
+				422,
+				"user jane is invalid",
+				NewErrorField("email", "jane@ is invalid"),
+				NewErrorField("name", "jane is too short"),
+				NewErrorField("name", "jane must be capitalized"),
+			),
+			Expected: "422|email,name",
+		},
+		{
+			Name: "same fields with different code",
+			Error: New(
+				400,
+				"user john is invalid",
+				NewErrorField("name", "john is too short"),
+				NewErrorField("email", "john@ is invalid"),
+			),
+			Expected: "400|email,name",
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = GroupKey(testCases[i].Error)
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected group key is %s, but got %s", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
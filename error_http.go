@@ -0,0 +1,205 @@
+package gocerr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	minStatusCode = 100
+	maxStatusCode = 599
+)
+
+// codeErrors holds the errors of NewFromCode for codes 100 to 599. It is
+// filled once at initialization and only read afterwards.
+var codeErrors [maxStatusCode - minStatusCode + 1]Error
+
+func init() {
+	for i := 0; i < len(codeErrors); i++ {
+		codeErrors[i] = Error{
+			Code:    minStatusCode + i,
+			Message: http.StatusText(minStatusCode + i),
+		}
+	}
+}
+
+// NewFromCode returns an error with the given code and its HTTP status text
+// as message. Errors for codes 100 to 599 come from a fixed table built at
+// initialization; they have a nil ErrorFields slice and must be treated as
+// immutable.
+func NewFromCode(code int) Error {
+	if code < minStatusCode || code > maxStatusCode {
+		return notifyNew(Error{
+			Code:    code,
+			Message: http.StatusText(code),
+		})
+	}
+
+	return notifyNew(codeErrors[code-minStatusCode])
+}
+
+// NewHTTP returns a new error with the given code and its HTTP status text
+// as message, or "Unknown" when the code has no status text.
+func NewHTTP(code int, errorFields ...ErrorField) Error {
+	var message string = http.StatusText(code)
+
+	if message == "" {
+		message = "Unknown"
+	}
+
+	return New(code, message, errorFields...)
+}
+
+// FromURLError converts a *url.Error found in err into an error with the
+// given code. The message is the operation and quoted URL, as in
+// `Get "http://example.com"`, and the underlying error becomes the cause
+// rather than being repeated in the message. It returns false when err is not
+// a *url.Error.
+func FromURLError(err error, code int) (Error, bool) {
+	var urlErr *url.Error
+
+	if !errors.As(err, &urlErr) {
+		return Error{}, false
+	}
+
+	return Wrap(code, urlErr.Op+" "+strconv.Quote(urlErr.URL), urlErr.Err), true
+}
+
+func sanitizeHeaderToken(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') ||
+			strings.ContainsRune("!#$%&'*+-.^_`|~", r) {
+			return r
+		}
+		return '-'
+	}, name)
+}
+
+// ToTrailers returns one X-Field-<name> header per error field with the field
+// message as value. Characters of the field name that are not valid in a
+// header token are replaced with '-'.
+func (e Error) ToTrailers() http.Header {
+	var trailers http.Header = make(http.Header, len(e.ErrorFields))
+
+	for i := 0; i < len(e.ErrorFields); i++ {
+		trailers.Add("X-Field-"+sanitizeHeaderToken(e.ErrorFields[i].Field), e.ErrorFields[i].Message)
+	}
+
+	return trailers
+}
+
+// ToQueryParams returns e as OAuth2-style error response parameters: "error"
+// holds the code and "error_description" the message.
+func (e Error) ToQueryParams() url.Values {
+	var params url.Values = url.Values{}
+
+	params.Set("error", strconv.Itoa(e.Code))
+	params.Set("error_description", e.Message)
+
+	return params
+}
+
+// FromMultiStatus builds a 207 Multi-Status error with one error field per
+// resource, sorted by resource name. Each field message is the sub-status
+// code followed by its status text, e.g. "404 Not Found".
+func FromMultiStatus(statuses map[string]int) Error {
+	var (
+		resources   []string = make([]string, 0, len(statuses))
+		errorFields []ErrorField
+		status      int
+	)
+
+	for resource := range statuses {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	errorFields = make([]ErrorField, 0, len(resources))
+	for i := 0; i < len(resources); i++ {
+		status = statuses[resources[i]]
+		errorFields = append(errorFields, NewErrorField(resources[i], strings.TrimSpace(strconv.Itoa(status)+" "+http.StatusText(status))))
+	}
+
+	return New(http.StatusMultiStatus, http.StatusText(http.StatusMultiStatus), errorFields...)
+}
+
+// WriteHTTP writes err as a JSON response. The status is the error code, or
+// 500 when the code is not an HTTP error status (400 to 599), since a 1xx
+// status would be sent as an informational response followed by an implicit
+// 200. Errors that are not custom errors are written as a generic 500 error.
+func WriteHTTP(w http.ResponseWriter, err error) {
+	var (
+		customError   Error
+		isCustomError bool
+		status        int
+	)
+
+	customError, isCustomError = Parse(err)
+	if !isCustomError {
+		customError = New(http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+	}
+
+	status = customError.Code
+	if status < 400 || status > 599 {
+		status = http.StatusInternalServerError
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(customError)
+}
+
+// FromDownstream builds a 502 error with one error field per failed
+// downstream call, named after the service (sorted) with its error message.
+// Nil results are skipped; when every call succeeded it returns an empty Error.
+func FromDownstream(results map[string]error) Error {
+	var (
+		services    []string
+		errorFields []ErrorField
+	)
+
+	for service, err := range results {
+		if err != nil {
+			services = append(services, service)
+		}
+	}
+
+	if len(services) == 0 {
+		return Error{}
+	}
+
+	sort.Strings(services)
+	errorFields = make([]ErrorField, 0, len(services))
+	for i := 0; i < len(services); i++ {
+		errorFields = append(errorFields, NewErrorField(services[i], results[services[i]].Error()))
+	}
+
+	return New(http.StatusBadGateway, http.StatusText(http.StatusBadGateway), errorFields...)
+}
+
+// ReasonPhrase returns the HTTP status text of the code ("Unknown" when
+// there is none), suffixed with the field count when e has error fields, as
+// in "Unprocessable Entity (2 errors)". The result is a single header-safe
+// line.
+func (e Error) ReasonPhrase() string {
+	var reason string = http.StatusText(e.Code)
+
+	if reason == "" {
+		reason = "Unknown"
+	}
+
+	switch len(e.ErrorFields) {
+	case 0:
+	case 1:
+		reason += " (1 error)"
+	default:
+		reason += " (" + strconv.Itoa(len(e.ErrorFields)) + " errors)"
+	}
+
+	return cleanText(reason)
+}
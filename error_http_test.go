@@ -0,0 +1,434 @@
+package gocerr
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+func TestNewFromCode(t *testing.T) {
+	var actual Error = NewFromCode(http.StatusNotFound)
+
+	if actual.Code != http.StatusNotFound {
+		t.Errorf("expected code is %d, but got %d", http.StatusNotFound, actual.Code)
+	}
+
+	if actual.Message != "Not Found" {
+		t.Errorf("expected message is %s, but got %s", "Not Found", actual.Message)
+	}
+
+	if actual.ErrorFields != nil {
+		t.Errorf("expected error fields is nil, but got %v", actual.ErrorFields)
+	}
+
+	actual = NewFromCode(799)
+	if actual.Code != 799 || actual.Message != "" {
+		t.Errorf("expected error out of table is [799], but got %s", actual.String())
+	}
+}
+
+func TestNewFromCode_Concurrent(t *testing.T) {
+	var (
+		codes []int = []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError}
+		wg    sync.WaitGroup
+	)
+
+	for i := 0; i < 64; i++ {
+		wg.Add(1)
+		go func(code int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				var actual Error = NewFromCode(code)
+				if actual.Code != code || actual.Message != http.StatusText(code) {
+					t.Errorf("expected error is [%d] %s, but got %s", code, http.StatusText(code), actual.String())
+				}
+			}
+		}(codes[i%len(codes)])
+	}
+
+	wg.Wait()
+}
+
+func BenchmarkNewFromCode(b *testing.B) {
+	var codes []int = []int{http.StatusBadRequest, http.StatusNotFound, http.StatusUnprocessableEntity, http.StatusInternalServerError}
+
+	b.Run("table", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = NewFromCode(codes[i%len(codes)])
+		}
+	})
+
+	b.Run("status text", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = New(codes[i%len(codes)], http.StatusText(codes[i%len(codes)]))
+		}
+	})
+}
+
+func TestNewHTTP(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Code        int
+		ErrorFields []ErrorField
+		Expected    Error
+	}{
+		{
+			Name:     "not found",
+			Code:     http.StatusNotFound,
+			Expected: Error{Code: http.StatusNotFound, Message: "Not Found"},
+		},
+		{
+			Name:        "unprocessable entity with error fields",
+			Code:        http.StatusUnprocessableEntity,
+			ErrorFields: []ErrorField{NewErrorField("email", "is required")},
+			Expected: Error{
+				Code:        http.StatusUnprocessableEntity,
+				Message:     "Unprocessable Entity",
+				ErrorFields: []ErrorField{NewErrorField("email", "is required")},
+			},
+		},
+		{
+			Name:     "invalid code",
+			Code:     799,
+			Expected: Error{Code: 799, Message: "Unknown"},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual Error = NewHTTP(testCases[i].Code, testCases[i].ErrorFields...)
+
+			if !testCases[i].Expected.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Expected.String(), actual.String())
+			}
+		})
+	}
+}
+
+func TestFromURLError(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Code     int
+		Expected struct {
+			CustomError Error
+			IsURLError  bool
+			String      string
+		}
+	}{
+		{
+			Name:  "error is not url error",
+			Error: errors.New("some error"),
+			Code:  http.StatusBadGateway,
+			Expected: struct {
+				CustomError Error
+				IsURLError  bool
+				String      string
+			}{
+				CustomError: Error{},
+				IsURLError:  false,
+				String:      Error{}.String(),
+			},
+		},
+		{
+			Name: "error is url error",
+			Error: &url.Error{
+				Op:  "Get",
+				URL: "http://example.com",
+				Err: io.ErrUnexpectedEOF,
+			},
+			Code: http.StatusBadGateway,
+			Expected: struct {
+				CustomError Error
+				IsURLError  bool
+				String      string
+			}{
+				CustomError: Wrap(http.StatusBadGateway, `Get "http://example.com"`, io.ErrUnexpectedEOF),
+				IsURLError:  true,
+				String:      `[502] Get "http://example.com" caused by: unexpected EOF`,
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			actualErr, actualIsURLError := FromURLError(testCases[i].Error, testCases[i].Code)
+
+			if testCases[i].Expected.IsURLError != actualIsURLError {
+				t.Errorf("expected is url error is %t, but got %t", testCases[i].Expected.IsURLError, actualIsURLError)
+			}
+
+			if testCases[i].Expected.CustomError.Code != actualErr.Code {
+				t.Errorf("expected code is %d, but got %d", testCases[i].Expected.CustomError.Code, actualErr.Code)
+			}
+
+			if testCases[i].Expected.CustomError.Message != actualErr.Message {
+				t.Errorf("expected message is %s, but got %s", testCases[i].Expected.CustomError.Message, actualErr.Message)
+			}
+
+			if testCases[i].Expected.CustomError.Cause != actualErr.Cause {
+				t.Errorf("expected cause is %v, but got %v", testCases[i].Expected.CustomError.Cause, actualErr.Cause)
+			}
+
+			if testCases[i].Expected.String != actualErr.String() {
+				t.Errorf("expected string is %s, but got %s", testCases[i].Expected.String, actualErr.String())
+			}
+		})
+	}
+}
+
+func TestError_ToTrailers(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Expected http.Header
+	}{
+		{
+			Name:     "no error fields",
+			Error:    New(http.StatusInternalServerError, "internal server error"),
+			Expected: http.Header{},
+		},
+		{
+			Name: "valid and invalid field names",
+			Error: New(
+				http.StatusBadRequest,
+				"bad request",
+				NewErrorField("email", "email is invalid"),
+				NewErrorField("user name", "field is required"),
+				NewErrorField("items[0]:id", "id is invalid"),
+			),
+			Expected: http.Header{
+				"X-Field-Email":       []string{"email is invalid"},
+				"X-Field-User-Name":   []string{"field is required"},
+				"X-Field-Items-0--Id": []string{"id is invalid"},
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual http.Header = testCases[i].Error.ToTrailers()
+
+			if len(testCases[i].Expected) != len(actual) {
+				t.Errorf("expected length of trailers is %d, but got %d", len(testCases[i].Expected), len(actual))
+			}
+
+			for key := range testCases[i].Expected {
+				if testCases[i].Expected.Get(key) != actual.Get(key) {
+					t.Errorf("expected trailer %s is %s, but got %s", key, testCases[i].Expected.Get(key), actual.Get(key))
+				}
+			}
+		})
+	}
+}
+
+func TestError_ToQueryParams(t *testing.T) {
+	var (
+		expected string = "error=400&error_description=invalid+redirect+uri"
+		actual   string = New(http.StatusBadRequest, "invalid redirect uri").ToQueryParams().Encode()
+	)
+
+	if expected != actual {
+		t.Errorf("expected query params is %s, but got %s", expected, actual)
+	}
+}
+
+func TestFromMultiStatus(t *testing.T) {
+	var (
+		expected Error = New(
+			http.StatusMultiStatus,
+			"Multi-Status",
+			NewErrorField("/items/1", "200 OK"),
+			NewErrorField("/items/2", "404 Not Found"),
+			NewErrorField("/items/3", "423 Locked"),
+			NewErrorField("/items/4", "799"),
+		)
+		actual Error = FromMultiStatus(map[string]int{
+			"/items/3": http.StatusLocked,
+			"/items/1": http.StatusOK,
+			"/items/4": 799,
+			"/items/2": http.StatusNotFound,
+		})
+	)
+
+	if !expected.EqualIgnoring(actual) {
+		t.Errorf("expected error is %s, but got %s", expected.String(), actual.String())
+	}
+}
+
+func TestWriteHTTP(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected struct {
+			Status int
+			Body   string
+		}
+	}{
+		{
+			Name:  "error is not custom error",
+			Error: errors.New("some error"),
+			Expected: struct {
+				Status int
+				Body   string
+			}{
+				Status: http.StatusInternalServerError,
+				Body:   `{"code":500,"message":"Internal Server Error"}` + "\n",
+			},
+		},
+		{
+			Name:  "error is custom error",
+			Error: New(http.StatusBadRequest, "bad request", NewErrorField("field1", "field is required")),
+			Expected: struct {
+				Status int
+				Body   string
+			}{
+				Status: http.StatusBadRequest,
+				Body:   `{"code":400,"message":"bad request","error_fields":[{"field":"field1","message":"field is required"}]}` + "\n",
+			},
+		},
+		{
+			Name:  "error code is not valid status",
+			Error: New(1001, "custom failure"),
+			Expected: struct {
+				Status int
+				Body   string
+			}{
+				Status: http.StatusInternalServerError,
+				Body:   `{"code":1001,"message":"custom failure"}` + "\n",
+			},
+		},
+		{
+			Name:  "error code is informational status",
+			Error: New(http.StatusContinue, "continue"),
+			Expected: struct {
+				Status int
+				Body   string
+			}{
+				Status: http.StatusInternalServerError,
+				Body:   `{"code":100,"message":"continue"}` + "\n",
+			},
+		},
+		{
+			Name:  "error code is success status",
+			Error: New(http.StatusOK, "ok"),
+			Expected: struct {
+				Status int
+				Body   string
+			}{
+				Status: http.StatusInternalServerError,
+				Body:   `{"code":200,"message":"ok"}` + "\n",
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var recorder *httptest.ResponseRecorder = httptest.NewRecorder()
+
+			WriteHTTP(recorder, testCases[i].Error)
+
+			if testCases[i].Expected.Status != recorder.Code {
+				t.Errorf("expected status is %d, but got %d", testCases[i].Expected.Status, recorder.Code)
+			}
+
+			if recorder.Header().Get("Content-Type") != "application/json" {
+				t.Errorf("expected content type is %s, but got %s", "application/json", recorder.Header().Get("Content-Type"))
+			}
+
+			if testCases[i].Expected.Body != recorder.Body.String() {
+				t.Errorf("expected body is %s, but got %s", testCases[i].Expected.Body, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestFromDownstream(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Results  map[string]error
+		Expected Error
+	}{
+		{
+			Name: "all succeed",
+			Results: map[string]error{
+				"inventory": nil,
+				"payment":   nil,
+			},
+			Expected: Error{},
+		},
+		{
+			Name: "mixed success and failure",
+			Results: map[string]error{
+				"shipping":  errors.New("connection refused"),
+				"inventory": nil,
+				"payment":   New(http.StatusServiceUnavailable, "payment provider unavailable"),
+			},
+			Expected: New(
+				http.StatusBadGateway,
+				"Bad Gateway",
+				NewErrorField("payment", "payment provider unavailable"),
+				NewErrorField("shipping", "connection refused"),
+			),
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual Error = FromDownstream(testCases[i].Results)
+
+			if !testCases[i].Expected.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Expected.String(), actual.String())
+			}
+
+			if testCases[i].Expected.IsEmpty() != actual.IsEmpty() {
+				t.Errorf("expected is empty is %t, but got %t", testCases[i].Expected.IsEmpty(), actual.IsEmpty())
+			}
+		})
+	}
+}
+
+func TestError_ReasonPhrase(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Expected string
+	}{
+		{
+			Name:     "plain error",
+			Error:    New(http.StatusNotFound, "user not found"),
+			Expected: "Not Found",
+		},
+		{
+			Name:     "one error field",
+			Error:    New(http.StatusUnprocessableEntity, "bad", NewErrorField("email", "invalid")),
+			Expected: "Unprocessable Entity (1 error)",
+		},
+		{
+			Name:     "multiple error fields",
+			Error:    New(http.StatusUnprocessableEntity, "bad", NewErrorField("email", "invalid"), NewErrorField("age", "too low")),
+			Expected: "Unprocessable Entity (2 errors)",
+		},
+		{
+			Name:     "unknown code",
+			Error:    New(799, "unknown"),
+			Expected: "Unknown",
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = testCases[i].Error.ReasonPhrase()
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected reason phrase is %s, but got %s", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package gocerr
+
+import "encoding/json"
+
+type errorJSON struct {
+	Code        int          `json:"code"`
+	Message     string       `json:"message"`
+	ErrorFields []ErrorField `json:"error_fields,omitempty"`
+	TraceID     string       `json:"trace_id,omitempty"`
+}
+
+type errorFieldJSON struct {
+	Field   string `json:"field"`
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message"`
+}
+
+func (ef ErrorField) MarshalJSON() ([]byte, error) {
+	return json.Marshal(errorFieldJSON{
+		Field:   ef.Field,
+		Code:    ef.Code,
+		Message: ef.Message,
+	})
+}
+
+func (ef *ErrorField) UnmarshalJSON(data []byte) error {
+	var (
+		efJSON errorFieldJSON
+		err    error
+	)
+
+	err = json.Unmarshal(data, &efJSON)
+	if err != nil {
+		return err
+	}
+
+	*ef = NewErrorFieldWithCode(efJSON.Field, efJSON.Code, efJSON.Message)
+
+	return nil
+}
+
+// MarshalJSON encodes e as
+// {"code":...,"message":...,"error_fields":[...],"trace_id":...} with
+// error_fields and trace_id omitted when empty. The cause is not encoded.
+func (e Error) MarshalJSON() ([]byte, error) {
+	return json.Marshal(errorJSON{
+		Code:        e.Code,
+		Message:     e.Message,
+		ErrorFields: e.ErrorFields,
+		TraceID:     e.TraceID,
+	})
+}
+
+func (e *Error) UnmarshalJSON(data []byte) error {
+	var (
+		errJSON errorJSON
+		err     error
+	)
+
+	err = json.Unmarshal(data, &errJSON)
+	if err != nil {
+		return err
+	}
+
+	*e = Error{
+		Code:        errJSON.Code,
+		Message:     errJSON.Message,
+		ErrorFields: errJSON.ErrorFields,
+		TraceID:     errJSON.TraceID,
+	}
+
+	return nil
+}
+
+// FieldsToJSON serializes only the error fields of err as a JSON array of
+// {"field":...,"message":...} objects. It always produces an array, so an
+// error without fields (or a non-custom error) is encoded as [] rather than
+// null.
+func FieldsToJSON(err error) ([]byte, error) {
+	var customError Error
+
+	customError, _ = Parse(err)
+	if customError.ErrorFields == nil {
+		return json.Marshal([]ErrorField{})
+	}
+
+	return json.Marshal(customError.ErrorFields)
+}
+
+// MarshalJSONStable encodes e with the keys in a fixed order (code, message,
+// error_fields, trace_id) and the error fields sorted by field name, then
+// message, code and message key, so the output is byte-identical regardless of
+// field insertion order.
+func (e Error) MarshalJSONStable() ([]byte, error) {
+	var sorted Error = e
+
+	sorted.ErrorFields = sortedErrorFields(e.ErrorFields)
+
+	return sorted.MarshalJSON()
+}
+
+// ToMap returns e in the same shape as its JSON form, with the error fields
+// as []map[string]any and error_fields and trace_id omitted when empty.
+func (e Error) ToMap() map[string]any {
+	var (
+		m           map[string]any
+		errorFields []map[string]any
+	)
+
+	m = map[string]any{
+		"code":    e.Code,
+		"message": e.Message,
+	}
+
+	if e.TraceID != "" {
+		m["trace_id"] = e.TraceID
+	}
+
+	if len(e.ErrorFields) == 0 {
+		return m
+	}
+
+	errorFields = make([]map[string]any, 0, len(e.ErrorFields))
+	for i := 0; i < len(e.ErrorFields); i++ {
+		errorFields = append(errorFields, map[string]any{
+			"field":   e.ErrorFields[i].Field,
+			"message": e.ErrorFields[i].Message,
+		})
+		if e.ErrorFields[i].Code != "" {
+			errorFields[i]["code"] = e.ErrorFields[i].Code
+		}
+	}
+	m["error_fields"] = errorFields
+
+	return m
+}
+
+// ToMap returns err in the shape of Error.ToMap, or nil when err is not a
+// custom error.
+func ToMap(err error) map[string]any {
+	var (
+		customError   Error
+		isCustomError bool
+	)
+
+	customError, isCustomError = Parse(err)
+	if !isCustomError {
+		return nil
+	}
+
+	return customError.ToMap()
+}
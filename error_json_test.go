@@ -0,0 +1,352 @@
+package gocerr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFieldsToJSON(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected string
+	}{
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Expected: `[]`,
+		},
+		{
+			Name:     "no error fields",
+			Error:    New(500, "internal server error"),
+			Expected: `[]`,
+		},
+		{
+			Name: "multiple error fields",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field2", "min value is 50"),
+			),
+			Expected: `[{"field":"field1","message":"field is required"},{"field":"field2","message":"min value is 50"}]`,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			actual, err := FieldsToJSON(testCases[i].Error)
+			if err != nil {
+				t.Fatalf("expected no error, but got %v", err)
+			}
+
+			if testCases[i].Expected != string(actual) {
+				t.Errorf("expected json is %s, but got %s", testCases[i].Expected, string(actual))
+			}
+		})
+	}
+}
+
+func TestError_MarshalJSONStable(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Errors   []Error
+		Expected string
+	}{
+		{
+			Name: "no error fields",
+			Errors: []Error{
+				New(500, "internal server error"),
+			},
+			Expected: `{"code":500,"message":"internal server error"}`,
+		},
+		{
+			Name: "different field insertion order",
+			Errors: []Error{
+				New(
+					400,
+					"bad request",
+					NewErrorField("field2", "min value is 50"),
+					NewErrorField("field1", "invalid format"),
+					NewErrorField("field1", "field is required"),
+				),
+				New(
+					400,
+					"bad request",
+					NewErrorField("field1", "field is required"),
+					NewErrorField("field2", "min value is 50"),
+					NewErrorField("field1", "invalid format"),
+				),
+			},
+			Expected: `{"code":400,"message":"bad request","error_fields":[{"field":"field1","message":"field is required"},{"field":"field1","message":"invalid format"},{"field":"field2","message":"min value is 50"}]}`,
+		},
+		{
+			Name: "same field and message differing only in code",
+			Errors: []Error{
+				New(
+					400,
+					"bad request",
+					NewErrorFieldWithCode("email", "required", "invalid email"),
+					NewErrorFieldWithCode("email", "format", "invalid email"),
+				),
+				New(
+					400,
+					"bad request",
+					NewErrorFieldWithCode("email", "format", "invalid email"),
+					NewErrorFieldWithCode("email", "required", "invalid email"),
+				),
+			},
+			Expected: `{"code":400,"message":"bad request","error_fields":[{"field":"email","code":"format","message":"invalid email"},{"field":"email","code":"required","message":"invalid email"}]}`,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			for j := 0; j < len(testCases[i].Errors); j++ {
+				for k := 0; k < 3; k++ {
+					actual, err := testCases[i].Errors[j].MarshalJSONStable()
+					if err != nil {
+						t.Fatalf("expected no error, but got %v", err)
+					}
+
+					if testCases[i].Expected != string(actual) {
+						t.Errorf("expected json is %s, but got %s", testCases[i].Expected, string(actual))
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestErrorField_MarshalJSON(t *testing.T) {
+	var (
+		expected string = `{"field":"field1","message":"field is required"}`
+		actual   []byte
+		err      error
+	)
+
+	actual, err = json.Marshal(NewErrorField("field1", "field is required"))
+	if err != nil {
+		t.Fatalf("expected no error, but got %v", err)
+	}
+
+	if expected != string(actual) {
+		t.Errorf("expected json is %s, but got %s", expected, string(actual))
+	}
+}
+
+func TestError_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Expected string
+	}{
+		{
+			Name:     "no error fields",
+			Error:    New(500, "internal server error"),
+			Expected: `{"code":500,"message":"internal server error"}`,
+		},
+		{
+			Name:     "empty error fields",
+			Error:    New(500, "internal server error", []ErrorField{}...),
+			Expected: `{"code":500,"message":"internal server error"}`,
+		},
+		{
+			Name: "with error fields",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field2", "min value is 50"),
+			),
+			Expected: `{"code":400,"message":"bad request","error_fields":[{"field":"field1","message":"field is required"},{"field":"field2","message":"min value is 50"}]}`,
+		},
+		{
+			Name:     "with error field code",
+			Error:    New(400, "bad request", NewErrorFieldWithCode("field1", "required", "field is required")),
+			Expected: `{"code":400,"message":"bad request","error_fields":[{"field":"field1","code":"required","message":"field is required"}]}`,
+		},
+		{
+			Name:     "with trace id",
+			Error:    New(400, "bad request", NewErrorField("field1", "field is required")).WithTraceID("req-123"),
+			Expected: `{"code":400,"message":"bad request","error_fields":[{"field":"field1","message":"field is required"}],"trace_id":"req-123"}`,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			actual, err := json.Marshal(testCases[i].Error)
+			if err != nil {
+				t.Fatalf("expected no error, but got %v", err)
+			}
+
+			if testCases[i].Expected != string(actual) {
+				t.Errorf("expected json is %s, but got %s", testCases[i].Expected, string(actual))
+			}
+		})
+	}
+}
+
+func TestError_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Error Error
+	}{
+		{
+			Name:  "no error fields",
+			Error: New(500, "internal server error"),
+		},
+		{
+			Name: "with error fields",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field2", "min value is 50"),
+			),
+		},
+		{
+			Name: "with error field code",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorFieldWithCode("field1", "required", "field is required"),
+			),
+		},
+		{
+			Name:  "with trace id",
+			Error: New(500, "internal server error").WithTraceID("req-123"),
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual Error
+
+			data, err := json.Marshal(testCases[i].Error)
+			if err != nil {
+				t.Fatalf("expected no marshal error, but got %v", err)
+			}
+
+			err = json.Unmarshal(data, &actual)
+			if err != nil {
+				t.Fatalf("expected no unmarshal error, but got %v", err)
+			}
+
+			if !testCases[i].Error.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Error.String(), actual.String())
+			}
+		})
+	}
+
+	t.Run("invalid json", func(t *testing.T) {
+		var actual Error
+
+		if json.Unmarshal([]byte(`{"code":"400"}`), &actual) == nil {
+			t.Errorf("expected unmarshal error, but got nil")
+		}
+	})
+}
+
+func TestError_ToMap(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Expected map[string]any
+	}{
+		{
+			Name:  "no error fields",
+			Error: New(500, "internal server error"),
+			Expected: map[string]any{
+				"code":    500,
+				"message": "internal server error",
+			},
+		},
+		{
+			Name: "multiple error fields",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field2", "min value is 50"),
+			),
+			Expected: map[string]any{
+				"code":    400,
+				"message": "bad request",
+				"error_fields": []map[string]any{
+					{"field": "field1", "message": "field is required"},
+					{"field": "field2", "message": "min value is 50"},
+				},
+			},
+		},
+		{
+			Name:  "with trace id",
+			Error: New(500, "internal server error").WithTraceID("req-123"),
+			Expected: map[string]any{
+				"code":     500,
+				"message":  "internal server error",
+				"trace_id": "req-123",
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual map[string]any = testCases[i].Error.ToMap()
+
+			if !reflect.DeepEqual(testCases[i].Expected, actual) {
+				t.Errorf("expected map is %v, but got %v", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestToMap(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected map[string]any
+	}{
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Expected: nil,
+		},
+		{
+			Name:  "no error fields",
+			Error: New(404, "not found"),
+			Expected: map[string]any{
+				"code":    404,
+				"message": "not found",
+			},
+		},
+		{
+			Name:  "wrapped error with error fields",
+			Error: fmt.Errorf("handler: %w", New(400, "bad request", NewErrorField("field1", "field is required"))),
+			Expected: map[string]any{
+				"code":    400,
+				"message": "bad request",
+				"error_fields": []map[string]any{
+					{"field": "field1", "message": "field is required"},
+				},
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual map[string]any = ToMap(testCases[i].Error)
+
+			if testCases[i].Expected == nil && actual != nil {
+				t.Fatalf("expected map is nil, but got %v", actual)
+			}
+
+			if !reflect.DeepEqual(testCases[i].Expected, actual) {
+				t.Errorf("expected map is %v, but got %v", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
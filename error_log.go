@@ -0,0 +1,58 @@
+package gocerr
+
+import (
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+func (e Error) logAttrs() []slog.Attr {
+	var (
+		attrs       []slog.Attr
+		fieldsAttrs []any
+	)
+
+	attrs = []slog.Attr{
+		slog.Int("code", e.Code),
+		slog.String("message", e.Message),
+	}
+
+	if e.TraceID != "" {
+		attrs = append(attrs, slog.String("trace_id", e.TraceID))
+	}
+
+	if len(e.ErrorFields) == 0 {
+		return attrs
+	}
+
+	// Fields are keyed by index rather than name, since a field name may
+	// repeat and handlers such as slog.JSONHandler would emit duplicate keys.
+	fieldsAttrs = make([]any, 0, len(e.ErrorFields))
+	for i := 0; i < len(e.ErrorFields); i++ {
+		fieldsAttrs = append(fieldsAttrs, slog.Group(
+			strconv.Itoa(i),
+			slog.String("field", e.ErrorFields[i].Field),
+			slog.String("message", e.ErrorFields[i].Message),
+		))
+	}
+
+	return append(attrs, slog.Group("error_fields", fieldsAttrs...))
+}
+
+// ToRecord builds a slog.Record at the given level with msg as the record
+// message and the code, message, trace ID (when set) and error fields of e
+// attached as attributes.
+func (e Error) ToRecord(level slog.Level, msg string) slog.Record {
+	var record slog.Record = slog.NewRecord(time.Now(), level, msg, 0)
+
+	record.AddAttrs(e.logAttrs()...)
+
+	return record
+}
+
+// LogValue makes Error a slog.LogValuer, logging it as a group of code,
+// message, trace_id when set and a nested error_fields group holding one {field, message} group
+// per error field, keyed by its index.
+func (e Error) LogValue() slog.Value {
+	return slog.GroupValue(e.logAttrs()...)
+}
@@ -0,0 +1,231 @@
+package gocerr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strconv"
+	"testing"
+)
+
+func TestError_ToRecord(t *testing.T) {
+	var (
+		buffer  bytes.Buffer
+		handler slog.Handler = slog.NewJSONHandler(&buffer, nil)
+		record  slog.Record
+		actual  map[string]any
+		err     error
+	)
+
+	record = New(
+		400,
+		"bad request",
+		NewErrorField("password", "too short"),
+		NewErrorField("password", "needs a digit"),
+	).WithTraceID("req-123").ToRecord(slog.LevelWarn, "validation failed")
+
+	if record.Level != slog.LevelWarn {
+		t.Errorf("expected level is %s, but got %s", slog.LevelWarn, record.Level)
+	}
+
+	err = handler.Handle(context.Background(), record)
+	if err != nil {
+		t.Fatalf("expected no handle error, but got %v", err)
+	}
+
+	err = json.Unmarshal(buffer.Bytes(), &actual)
+	if err != nil {
+		t.Fatalf("expected no unmarshal error, but got %v", err)
+	}
+
+	if actual["msg"] != "validation failed" {
+		t.Errorf("expected msg is %s, but got %v", "validation failed", actual["msg"])
+	}
+
+	if actual["code"] != float64(400) {
+		t.Errorf("expected code is %d, but got %v", 400, actual["code"])
+	}
+
+	if actual["message"] != "bad request" {
+		t.Errorf("expected message is %s, but got %v", "bad request", actual["message"])
+	}
+
+	if actual["trace_id"] != "req-123" {
+		t.Errorf("expected trace_id is %s, but got %v", "req-123", actual["trace_id"])
+	}
+
+	errorFields, isMap := actual["error_fields"].(map[string]any)
+	if !isMap {
+		t.Fatalf("expected error_fields is a group, but got %v", actual["error_fields"])
+	}
+
+	expectedFields := []map[string]any{
+		{"field": "password", "message": "too short"},
+		{"field": "password", "message": "needs a digit"},
+	}
+
+	if len(expectedFields) != len(errorFields) {
+		t.Fatalf("expected length of error_fields is %d, but got %d", len(expectedFields), len(errorFields))
+	}
+
+	for i := 0; i < len(expectedFields); i++ {
+		errorField, isMap := errorFields[strconv.Itoa(i)].(map[string]any)
+		if !isMap {
+			t.Fatalf("expected error field %d is a group, but got %v", i, errorFields[strconv.Itoa(i)])
+		}
+
+		if expectedFields[i]["field"] != errorField["field"] || expectedFields[i]["message"] != errorField["message"] {
+			t.Errorf("expected error field %d is %v, but got %v", i, expectedFields[i], errorField)
+		}
+	}
+}
+
+func TestError_LogValue(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Error          Error
+		ExpectedAttrs  map[string]string
+		ExpectedFields []ErrorField
+	}{
+		{
+			Name:  "no error fields",
+			Error: New(500, "internal server error"),
+			ExpectedAttrs: map[string]string{
+				"code":    "500",
+				"message": "internal server error",
+			},
+		},
+		{
+			Name: "with error fields",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field2", "min value is 50"),
+			),
+			ExpectedAttrs: map[string]string{
+				"code":    "400",
+				"message": "bad request",
+			},
+			ExpectedFields: []ErrorField{
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field2", "min value is 50"),
+			},
+		},
+		{
+			Name:  "with trace id",
+			Error: New(500, "internal server error").WithTraceID("req-123"),
+			ExpectedAttrs: map[string]string{
+				"code":     "500",
+				"message":  "internal server error",
+				"trace_id": "req-123",
+			},
+		},
+		{
+			Name: "with repeated field name",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("password", "too short"),
+				NewErrorField("password", "needs a digit"),
+			),
+			ExpectedAttrs: map[string]string{
+				"code":    "400",
+				"message": "bad request",
+			},
+			ExpectedFields: []ErrorField{
+				NewErrorField("password", "too short"),
+				NewErrorField("password", "needs a digit"),
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				value        slog.Value = testCases[i].Error.LogValue()
+				actualAttrs  map[string]string
+				actualFields []ErrorField
+			)
+
+			if value.Kind() != slog.KindGroup {
+				t.Fatalf("expected kind is %s, but got %s", slog.KindGroup, value.Kind())
+			}
+
+			actualAttrs = map[string]string{}
+			for _, attr := range value.Group() {
+				if attr.Key != "error_fields" {
+					actualAttrs[attr.Key] = attr.Value.String()
+					continue
+				}
+
+				if attr.Value.Kind() != slog.KindGroup {
+					t.Fatalf("expected error_fields kind is %s, but got %s", slog.KindGroup, attr.Value.Kind())
+				}
+
+				for j, fieldAttr := range attr.Value.Group() {
+					var actualField ErrorField
+
+					if fieldAttr.Key != strconv.Itoa(j) {
+						t.Errorf("expected error field key is %d, but got %s", j, fieldAttr.Key)
+					}
+
+					for _, valueAttr := range fieldAttr.Value.Group() {
+						switch valueAttr.Key {
+						case "field":
+							actualField.Field = valueAttr.Value.String()
+						case "message":
+							actualField.Message = valueAttr.Value.String()
+						}
+					}
+
+					actualFields = append(actualFields, actualField)
+				}
+			}
+
+			if len(testCases[i].ExpectedAttrs) != len(actualAttrs) {
+				t.Errorf("expected attrs is %v, but got %v", testCases[i].ExpectedAttrs, actualAttrs)
+			}
+
+			for key, expected := range testCases[i].ExpectedAttrs {
+				if expected != actualAttrs[key] {
+					t.Errorf("expected attr %s is %s, but got %s", key, expected, actualAttrs[key])
+				}
+			}
+
+			if len(testCases[i].ExpectedFields) != len(actualFields) {
+				t.Fatalf("expected error fields is %v, but got %v", testCases[i].ExpectedFields, actualFields)
+			}
+
+			for j := 0; j < len(testCases[i].ExpectedFields); j++ {
+				if testCases[i].ExpectedFields[j] != actualFields[j] {
+					t.Errorf("expected error field is %v, but got %v", testCases[i].ExpectedFields[j], actualFields[j])
+				}
+			}
+		})
+	}
+}
+
+func TestError_LogValue_Logger(t *testing.T) {
+	var (
+		buffer bytes.Buffer
+		logger *slog.Logger = slog.New(slog.NewJSONHandler(&buffer, nil))
+		actual map[string]any
+	)
+
+	logger.Error("failed", "err", New(400, "bad request", NewErrorField("field1", "field is required")))
+
+	if err := json.Unmarshal(buffer.Bytes(), &actual); err != nil {
+		t.Fatalf("expected no unmarshal error, but got %v", err)
+	}
+
+	errAttr, isMap := actual["err"].(map[string]any)
+	if !isMap {
+		t.Fatalf("expected err is a group, but got %v", actual["err"])
+	}
+
+	if errAttr["code"] != float64(400) || errAttr["message"] != "bad request" {
+		t.Errorf("expected err group has code and message, but got %v", errAttr)
+	}
+}
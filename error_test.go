@@ -1,9 +1,15 @@
 package gocerr
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"io"
+	"io/fs"
 	"net/http"
+	"regexp"
 	"testing"
+	"time"
 )
 
 func TestNew(t *testing.T) {
@@ -77,6 +83,26 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNewAt(t *testing.T) {
+	var (
+		ts       time.Time = time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC)
+		expected Error     = New(400, "bad request", NewErrorField("field1", "field is required")).WithTimestamp(ts)
+		actual   Error     = NewAt(400, "bad request", ts, NewErrorField("field1", "field is required"))
+	)
+
+	if !expected.EqualIgnoring(actual) {
+		t.Errorf("expected error is %s, but got %s", expected.String(), actual.String())
+	}
+
+	if !actual.Timestamp.Equal(ts) {
+		t.Errorf("expected timestamp is %v, but got %v", ts, actual.Timestamp)
+	}
+
+	if !New(400, "bad request").Timestamp.IsZero() {
+		t.Errorf("expected timestamp of New is zero")
+	}
+}
+
 func TestError_Error(t *testing.T) {
 	var (
 		expectedMessage string
@@ -133,6 +159,28 @@ func TestParse(t *testing.T) {
 				IsCustomError: true,
 			},
 		},
+		{
+			Name:  "custom error wrapped one level deep",
+			Error: fmt.Errorf("context: %w", New(404, "not found")),
+			Expected: struct {
+				CustomError   Error
+				IsCustomError bool
+			}{
+				CustomError:   New(404, "not found"),
+				IsCustomError: true,
+			},
+		},
+		{
+			Name:  "custom error wrapped two levels deep",
+			Error: fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", New(400, "bad request", NewErrorField("field1", "field is required")))),
+			Expected: struct {
+				CustomError   Error
+				IsCustomError bool
+			}{
+				CustomError:   New(400, "bad request", NewErrorField("field1", "field is required")),
+				IsCustomError: true,
+			},
+		},
 		{
 			Name:  "error is custom error with error fields",
 			Error: New(400, "bad request", NewErrorField("field1", "field is required")),
@@ -167,7 +215,7 @@ func TestParse(t *testing.T) {
 				t.Errorf("expected custom error message is %s, but got %s", testCases[i].Expected.CustomError.Message, actualCustomError.Message)
 			}
 
-			if testCases[i].Error != nil && testCases[i].Expected.IsCustomError && testCases[i].Error.Error() != actualCustomError.Message {
+			if testCases[i].Error != nil && testCases[i].Expected.IsCustomError && testCases[i].Expected.CustomError.Error() != actualCustomError.Message {
 				t.Errorf("expected error message is %s, but got %s", testCases[i].Error.Error(), actualCustomError.Message)
 			}
 
@@ -187,6 +235,82 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestNormalize(t *testing.T) {
+	var (
+		customError Error = New(http.StatusBadRequest, "bad request", NewErrorField("email", "invalid format"))
+		genericErr  error = errors.New("some error")
+		notExistErr error = fmt.Errorf("open config: %w", fs.ErrNotExist)
+	)
+
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected struct {
+			CustomError Error
+			Cause       error
+		}
+	}{
+		{
+			Name:  "error is nil",
+			Error: nil,
+			Expected: struct {
+				CustomError Error
+				Cause       error
+			}{
+				CustomError: Error{},
+				Cause:       nil,
+			},
+		},
+		{
+			Name:  "error is wrapped custom error",
+			Error: fmt.Errorf("handler: %w", customError),
+			Expected: struct {
+				CustomError Error
+				Cause       error
+			}{
+				CustomError: customError,
+				Cause:       nil,
+			},
+		},
+		{
+			Name:  "error is stdlib sentinel",
+			Error: notExistErr,
+			Expected: struct {
+				CustomError Error
+				Cause       error
+			}{
+				CustomError: New(http.StatusNotFound, "Not Found"),
+				Cause:       notExistErr,
+			},
+		},
+		{
+			Name:  "error is generic error",
+			Error: genericErr,
+			Expected: struct {
+				CustomError Error
+				Cause       error
+			}{
+				CustomError: New(http.StatusInternalServerError, "Internal Server Error"),
+				Cause:       genericErr,
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual Error = Normalize(testCases[i].Error)
+
+			if !testCases[i].Expected.CustomError.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Expected.CustomError.String(), actual.String())
+			}
+
+			if testCases[i].Expected.Cause != actual.Cause {
+				t.Errorf("expected cause is %v, but got %v", testCases[i].Expected.Cause, actual.Cause)
+			}
+		})
+	}
+}
+
 func TesGetErrorCode(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -260,3 +384,1968 @@ func TestIsErrorCodeEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestError_EqualIgnoring(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Other    Error
+		Ignore   []string
+		Expected bool
+	}{
+		{
+			Name:     "equal errors",
+			Error:    New(400, "bad request", NewErrorField("field1", "field is required")),
+			Other:    New(400, "bad request", NewErrorField("field1", "field is required")),
+			Expected: true,
+		},
+		{
+			Name:     "equal errors with ignored aspects",
+			Error:    New(400, "bad request", NewErrorField("field1", "field is required")),
+			Other:    New(400, "bad request", NewErrorField("field1", "field is required")),
+			Ignore:   []string{"timestamp", "traceid", "stack"},
+			Expected: true,
+		},
+		{
+			Name:     "different code",
+			Error:    New(400, "bad request"),
+			Other:    New(500, "bad request"),
+			Ignore:   []string{"traceid"},
+			Expected: false,
+		},
+		{
+			Name:     "different message",
+			Error:    New(400, "bad request"),
+			Other:    New(400, "invalid request"),
+			Expected: false,
+		},
+		{
+			Name:     "different message key",
+			Error:    Error{Code: 400, Message: "bad request", MessageKey: "errors.bad_request"},
+			Other:    New(400, "bad request"),
+			Expected: false,
+		},
+		{
+			Name:     "different error fields",
+			Error:    New(400, "bad request", NewErrorField("field1", "field is required")),
+			Other:    New(400, "bad request", NewErrorField("field2", "field is required")),
+			Expected: false,
+		},
+		{
+			Name:     "different length of error fields",
+			Error:    New(400, "bad request", NewErrorField("field1", "field is required")),
+			Other:    New(400, "bad request"),
+			Expected: false,
+		},
+		{
+			Name:     "different trace id",
+			Error:    New(400, "bad request").WithTraceID("req-123"),
+			Other:    New(400, "bad request").WithTraceID("req-456"),
+			Expected: false,
+		},
+		{
+			Name:     "different trace id ignored",
+			Error:    New(400, "bad request").WithTraceID("req-123"),
+			Other:    New(400, "bad request").WithTraceID("req-456"),
+			Ignore:   []string{"traceid"},
+			Expected: true,
+		},
+		{
+			Name:     "different timestamp",
+			Error:    NewAt(400, "bad request", time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC)),
+			Other:    NewAt(400, "bad request", time.Date(2024, time.March, 1, 10, 31, 0, 0, time.UTC)),
+			Expected: false,
+		},
+		{
+			Name:     "different timestamp ignored",
+			Error:    NewAt(400, "bad request", time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC)),
+			Other:    NewAt(400, "bad request", time.Date(2024, time.March, 1, 10, 31, 0, 0, time.UTC)),
+			Ignore:   []string{"timestamp"},
+			Expected: true,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = testCases[i].Error.EqualIgnoring(testCases[i].Other, testCases[i].Ignore...)
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected equal is %t, but got %t", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		A        error
+		B        error
+		Expected struct {
+			Equal            bool
+			EqualIgnoreOrder bool
+		}
+	}{
+		{
+			Name: "error is not custom error",
+			A:    errors.New("some error"),
+			B:    errors.New("some error"),
+			Expected: struct {
+				Equal            bool
+				EqualIgnoreOrder bool
+			}{
+				Equal:            false,
+				EqualIgnoreOrder: false,
+			},
+		},
+		{
+			Name: "equal errors",
+			A:    New(400, "bad request", NewErrorField("field1", "field is required")),
+			B:    fmt.Errorf("handler: %w", New(400, "bad request", NewErrorField("field1", "field is required"))),
+			Expected: struct {
+				Equal            bool
+				EqualIgnoreOrder bool
+			}{
+				Equal:            true,
+				EqualIgnoreOrder: true,
+			},
+		},
+		{
+			Name: "different code",
+			A:    New(400, "bad request"),
+			B:    New(422, "bad request"),
+			Expected: struct {
+				Equal            bool
+				EqualIgnoreOrder bool
+			}{
+				Equal:            false,
+				EqualIgnoreOrder: false,
+			},
+		},
+		{
+			Name: "different field order",
+			A:    New(400, "bad request", NewErrorField("field1", "field is required"), NewErrorField("field2", "min value is 50")),
+			B:    New(400, "bad request", NewErrorField("field2", "min value is 50"), NewErrorField("field1", "field is required")),
+			Expected: struct {
+				Equal            bool
+				EqualIgnoreOrder bool
+			}{
+				Equal:            false,
+				EqualIgnoreOrder: true,
+			},
+		},
+		{
+			Name: "different message key",
+			A:    Error{Code: 400, Message: "bad request", MessageKey: "errors.bad_request"},
+			B:    New(400, "bad request"),
+			Expected: struct {
+				Equal            bool
+				EqualIgnoreOrder bool
+			}{
+				Equal:            false,
+				EqualIgnoreOrder: false,
+			},
+		},
+		{
+			Name: "same field and message differing only in code",
+			A:    New(400, "bad request", NewErrorFieldWithCode("email", "required", "invalid email"), NewErrorFieldWithCode("email", "format", "invalid email")),
+			B:    New(400, "bad request", NewErrorFieldWithCode("email", "format", "invalid email"), NewErrorFieldWithCode("email", "required", "invalid email")),
+			Expected: struct {
+				Equal            bool
+				EqualIgnoreOrder bool
+			}{
+				Equal:            false,
+				EqualIgnoreOrder: true,
+			},
+		},
+		{
+			Name: "different error fields",
+			A:    New(400, "bad request", NewErrorField("field1", "field is required")),
+			B:    New(400, "bad request", NewErrorField("field2", "field is required")),
+			Expected: struct {
+				Equal            bool
+				EqualIgnoreOrder bool
+			}{
+				Equal:            false,
+				EqualIgnoreOrder: false,
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualEqual            bool = Equal(testCases[i].A, testCases[i].B)
+				actualEqualIgnoreOrder bool = EqualIgnoreOrder(testCases[i].A, testCases[i].B)
+			)
+
+			if testCases[i].Expected.Equal != actualEqual {
+				t.Errorf("expected equal is %t, but got %t", testCases[i].Expected.Equal, actualEqual)
+			}
+
+			if testCases[i].Expected.EqualIgnoreOrder != actualEqualIgnoreOrder {
+				t.Errorf("expected equal ignore order is %t, but got %t", testCases[i].Expected.EqualIgnoreOrder, actualEqualIgnoreOrder)
+			}
+		})
+	}
+}
+
+func TestError_MessageOrDefault(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Expected string
+	}{
+		{
+			Name:     "empty message with known code",
+			Error:    New(http.StatusNotFound, ""),
+			Expected: "Not Found",
+		},
+		{
+			Name:     "empty message with unknown code",
+			Error:    New(799, ""),
+			Expected: "error",
+		},
+		{
+			Name:     "non empty message",
+			Error:    New(http.StatusNotFound, "user not found"),
+			Expected: "user not found",
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = testCases[i].Error.MessageOrDefault()
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected message is %s, but got %s", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestCollectFields(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ErrorFields   []ErrorField
+		ExpectedFound bool
+	}{
+		{
+			Name:          "no error fields",
+			ErrorFields:   []ErrorField{},
+			ExpectedFound: false,
+		},
+		{
+			Name: "several error fields",
+			ErrorFields: []ErrorField{
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field2", "min value is 50"),
+				NewErrorField("field3", "invalid format"),
+			},
+			ExpectedFound: true,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var ch chan ErrorField = make(chan ErrorField, len(testCases[i].ErrorFields))
+
+			for j := 0; j < len(testCases[i].ErrorFields); j++ {
+				ch <- testCases[i].ErrorFields[j]
+			}
+			close(ch)
+
+			actualErr, actualFound := CollectFields(400, "bad request", ch)
+
+			if testCases[i].ExpectedFound != actualFound {
+				t.Errorf("expected found is %t, but got %t", testCases[i].ExpectedFound, actualFound)
+			}
+
+			if actualErr.Code != 400 {
+				t.Errorf("expected code is %d, but got %d", 400, actualErr.Code)
+			}
+
+			if len(testCases[i].ErrorFields) != len(actualErr.ErrorFields) {
+				t.Fatalf("expected length of error fields is %d, but got %d", len(testCases[i].ErrorFields), len(actualErr.ErrorFields))
+			}
+
+			for j := 0; j < len(testCases[i].ErrorFields); j++ {
+				if testCases[i].ErrorFields[j] != actualErr.ErrorFields[j] {
+					t.Errorf("expected error field is %v, but got %v", testCases[i].ErrorFields[j], actualErr.ErrorFields[j])
+				}
+			}
+		})
+	}
+}
+
+func TestError_MaskFields(t *testing.T) {
+	var (
+		original Error
+		expected []ErrorField
+		actual   Error
+	)
+
+	original = New(
+		400,
+		"bad request",
+		NewErrorField("name", "name is required"),
+		NewErrorField("ssn", "ssn 123-45-6789 is invalid"),
+		NewErrorField("email", "email is invalid"),
+	)
+	expected = []ErrorField{
+		NewErrorField("name", "name is required"),
+		NewErrorField("ssn", FieldMask),
+		NewErrorField("email", "email is invalid"),
+	}
+
+	actual = original.MaskFields("ssn")
+
+	if len(expected) != len(actual.ErrorFields) {
+		t.Fatalf("expected length of error fields is %d, but got %d", len(expected), len(actual.ErrorFields))
+	}
+
+	for i := 0; i < len(expected); i++ {
+		if expected[i] != actual.ErrorFields[i] {
+			t.Errorf("expected error field is %v, but got %v", expected[i], actual.ErrorFields[i])
+		}
+	}
+
+	if original.ErrorFields[1].Message != "ssn 123-45-6789 is invalid" {
+		t.Errorf("expected original error field message is unchanged, but got %s", original.ErrorFields[1].Message)
+	}
+}
+
+func TestError_WithContextf(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Format   string
+		Args     []any
+		Expected string
+	}{
+		{
+			Name:     "with formatted args",
+			Error:    New(404, "user not found"),
+			Format:   "id=%d, tenant=%s",
+			Args:     []any{42, "acme"},
+			Expected: "user not found (id=42, tenant=acme)",
+		},
+		{
+			Name:     "empty original message",
+			Error:    New(404, ""),
+			Format:   "id=%d",
+			Args:     []any{42},
+			Expected: "(id=42)",
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual Error = testCases[i].Error.WithContextf(testCases[i].Format, testCases[i].Args...)
+
+			if testCases[i].Expected != actual.Message {
+				t.Errorf("expected message is %s, but got %s", testCases[i].Expected, actual.Message)
+			}
+
+			if testCases[i].Error.Code != actual.Code {
+				t.Errorf("expected code is %d, but got %d", testCases[i].Error.Code, actual.Code)
+			}
+		})
+	}
+}
+
+func TestUnjoinErrors(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected []Error
+	}{
+		{
+			Name:     "error is nil",
+			Error:    nil,
+			Expected: nil,
+		},
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Expected: nil,
+		},
+		{
+			Name:     "error is custom error",
+			Error:    New(400, "bad request"),
+			Expected: []Error{New(400, "bad request")},
+		},
+		{
+			Name: "joined errors",
+			Error: errors.Join(
+				New(400, "bad request"),
+				errors.New("some error"),
+				New(404, "not found"),
+			),
+			Expected: []Error{New(400, "bad request"), New(404, "not found")},
+		},
+		{
+			Name: "joined errors with wrapped custom error",
+			Error: errors.Join(
+				fmt.Errorf("context: %w", New(400, "bad request")),
+				New(404, "not found"),
+			),
+			Expected: []Error{New(400, "bad request"), New(404, "not found")},
+		},
+		{
+			Name: "nested joined errors",
+			Error: errors.Join(
+				New(400, "bad request"),
+				errors.Join(
+					New(404, "not found"),
+					errors.Join(errors.New("some error"), New(409, "conflict")),
+				),
+				New(500, "internal server error"),
+			),
+			Expected: []Error{
+				New(400, "bad request"),
+				New(404, "not found"),
+				New(409, "conflict"),
+				New(500, "internal server error"),
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []Error = UnjoinErrors(testCases[i].Error)
+
+			if len(testCases[i].Expected) != len(actual) {
+				t.Fatalf("expected length of errors is %d, but got %d", len(testCases[i].Expected), len(actual))
+			}
+
+			for j := 0; j < len(testCases[i].Expected); j++ {
+				if !testCases[i].Expected[j].EqualIgnoring(actual[j]) {
+					t.Errorf("expected error is %v, but got %v", testCases[i].Expected[j], actual[j])
+				}
+			}
+		})
+	}
+}
+
+func TestRecode(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		NewCode  int
+		Expected Error
+	}{
+		{
+			Name:     "error is nil",
+			Error:    nil,
+			NewCode:  http.StatusInternalServerError,
+			Expected: New(http.StatusInternalServerError, ""),
+		},
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			NewCode:  http.StatusInternalServerError,
+			Expected: New(http.StatusInternalServerError, "some error"),
+		},
+		{
+			Name:     "error is custom error",
+			Error:    New(http.StatusBadRequest, "bad request", NewErrorField("field1", "field is required")),
+			NewCode:  http.StatusUnprocessableEntity,
+			Expected: New(http.StatusUnprocessableEntity, "bad request", NewErrorField("field1", "field is required")),
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual Error = Recode(testCases[i].Error, testCases[i].NewCode)
+
+			if !testCases[i].Expected.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Expected.String(), actual.String())
+			}
+		})
+	}
+
+	t.Run("non custom error is kept as cause", func(t *testing.T) {
+		if !errors.Is(Recode(io.EOF, http.StatusInternalServerError), io.EOF) {
+			t.Errorf("expected recoded error wraps %v", io.EOF)
+		}
+	})
+}
+
+func TestError_FieldsMatch(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Expected map[string]string
+		Match    bool
+	}{
+		{
+			Name:     "no error fields",
+			Error:    New(500, "internal server error"),
+			Expected: map[string]string{},
+			Match:    true,
+		},
+		{
+			Name: "exact match in different order",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("field2", "min value is 50"),
+				NewErrorField("field1", "field is required"),
+			),
+			Expected: map[string]string{
+				"field1": "field is required",
+				"field2": "min value is 50",
+			},
+			Match: true,
+		},
+		{
+			Name: "extra field",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field2", "min value is 50"),
+			),
+			Expected: map[string]string{
+				"field1": "field is required",
+			},
+			Match: false,
+		},
+		{
+			Name: "message mismatch",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("field1", "field is required"),
+			),
+			Expected: map[string]string{
+				"field1": "invalid format",
+			},
+			Match: false,
+		},
+		{
+			Name: "duplicate field",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field1", "field is required"),
+			),
+			Expected: map[string]string{
+				"field1": "field is required",
+				"field2": "min value is 50",
+			},
+			Match: false,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = testCases[i].Error.FieldsMatch(testCases[i].Expected)
+
+			if testCases[i].Match != actual {
+				t.Errorf("expected fields match is %t, but got %t", testCases[i].Match, actual)
+			}
+		})
+	}
+}
+
+func TestError_CombineWith(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Other    Error
+		Expected Error
+	}{
+		{
+			Name:  "client error combined with server error",
+			Error: New(400, "bad request", NewErrorField("field1", "field is required")),
+			Other: New(500, "internal server error", NewErrorField("field2", "min value is 50")),
+			Expected: New(
+				500,
+				"internal server error",
+				NewErrorField("field1", "field is required"),
+				NewErrorField("field2", "min value is 50"),
+			),
+		},
+		{
+			Name:  "server error combined with client error",
+			Error: New(500, "internal server error", NewErrorField("field2", "min value is 50")),
+			Other: New(400, "bad request", NewErrorField("field1", "field is required")),
+			Expected: New(
+				500,
+				"internal server error",
+				NewErrorField("field2", "min value is 50"),
+				NewErrorField("field1", "field is required"),
+			),
+		},
+		{
+			Name:  "same class with higher code and duplicate field",
+			Error: New(400, "bad request", NewErrorField("field1", "field is required")),
+			Other: New(422, "unprocessable entity", NewErrorField("field1", "field is required")),
+			Expected: New(
+				422,
+				"unprocessable entity",
+				NewErrorField("field1", "field is required"),
+			),
+		},
+		{
+			Name:     "same code",
+			Error:    New(400, "bad request"),
+			Other:    New(400, "invalid request"),
+			Expected: New(400, "bad request"),
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual Error = testCases[i].Error.CombineWith(testCases[i].Other)
+
+			if !testCases[i].Expected.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Expected.String(), actual.String())
+			}
+		})
+	}
+}
+
+func TestMostSevere(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Errors   []error
+		Expected struct {
+			CustomError   Error
+			IsCustomError bool
+		}
+	}{
+		{
+			Name:   "no errors",
+			Errors: nil,
+			Expected: struct {
+				CustomError   Error
+				IsCustomError bool
+			}{
+				CustomError:   Error{},
+				IsCustomError: false,
+			},
+		},
+		{
+			Name:   "no custom errors",
+			Errors: []error{errors.New("some error"), nil},
+			Expected: struct {
+				CustomError   Error
+				IsCustomError bool
+			}{
+				CustomError:   Error{},
+				IsCustomError: false,
+			},
+		},
+		{
+			Name: "higher code class wins",
+			Errors: []error{
+				New(499, "client closed request"),
+				errors.New("some error"),
+				fmt.Errorf("handler: %w", New(500, "internal server error")),
+				New(404, "not found"),
+			},
+			Expected: struct {
+				CustomError   Error
+				IsCustomError bool
+			}{
+				CustomError:   New(500, "internal server error"),
+				IsCustomError: true,
+			},
+		},
+		{
+			Name: "higher code wins within a class",
+			Errors: []error{
+				New(400, "bad request"),
+				New(422, "unprocessable entity"),
+				New(404, "not found"),
+			},
+			Expected: struct {
+				CustomError   Error
+				IsCustomError bool
+			}{
+				CustomError:   New(422, "unprocessable entity"),
+				IsCustomError: true,
+			},
+		},
+		{
+			Name: "first wins on equal codes",
+			Errors: []error{
+				New(503, "database unavailable"),
+				New(503, "cache unavailable"),
+			},
+			Expected: struct {
+				CustomError   Error
+				IsCustomError bool
+			}{
+				CustomError:   New(503, "database unavailable"),
+				IsCustomError: true,
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			actual, actualIsCustomError := MostSevere(testCases[i].Errors...)
+
+			if testCases[i].Expected.IsCustomError != actualIsCustomError {
+				t.Errorf("expected is custom error is %t, but got %t", testCases[i].Expected.IsCustomError, actualIsCustomError)
+			}
+
+			if !testCases[i].Expected.CustomError.EqualIgnoring(actual) {
+				t.Errorf("expected most severe error is %+v, but got %+v", testCases[i].Expected.CustomError, actual)
+			}
+		})
+	}
+}
+
+func TestError_RenameFields(t *testing.T) {
+	var (
+		original Error
+		expected []ErrorField
+		actual   Error
+	)
+
+	original = New(
+		400,
+		"bad request",
+		NewErrorField("user_name", "field is required"),
+		NewErrorField("email", "email is invalid"),
+	)
+	expected = []ErrorField{
+		NewErrorField("username", "field is required"),
+		NewErrorField("email", "email is invalid"),
+	}
+
+	actual = original.RenameFields(map[string]string{
+		"user_name": "username",
+		"phone":     "phone_number",
+	})
+
+	if len(expected) != len(actual.ErrorFields) {
+		t.Fatalf("expected length of error fields is %d, but got %d", len(expected), len(actual.ErrorFields))
+	}
+
+	for i := 0; i < len(expected); i++ {
+		if expected[i] != actual.ErrorFields[i] {
+			t.Errorf("expected error field is %s, but got %s", expected[i].String(), actual.ErrorFields[i].String())
+		}
+	}
+
+	if original.ErrorFields[0].Field != "user_name" {
+		t.Errorf("expected original field is unchanged, but got %s", original.ErrorFields[0].Field)
+	}
+}
+
+func TestParseFieldLines(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Text     string
+		Expected []ErrorField
+	}{
+		{
+			Name:     "empty text",
+			Text:     "",
+			Expected: []ErrorField{},
+		},
+		{
+			Name: "well formed lines",
+			Text: "email=invalid format\r\nage=must be at least 18\n",
+			Expected: []ErrorField{
+				NewErrorField("email", "invalid format"),
+				NewErrorField("age", "must be at least 18"),
+			},
+		},
+		{
+			Name: "malformed lines are skipped",
+			Text: "email=invalid format\nnot a field line\n=missing field\nname = is required",
+			Expected: []ErrorField{
+				NewErrorField("email", "invalid format"),
+				NewErrorField("name", "is required"),
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual Error = ParseFieldLines(400, "bad request", testCases[i].Text)
+
+			if actual.Code != 400 || actual.Message != "bad request" {
+				t.Errorf("expected error is [400] bad request, but got [%d] %s", actual.Code, actual.Message)
+			}
+
+			if len(testCases[i].Expected) != len(actual.ErrorFields) {
+				t.Fatalf("expected length of error fields is %d, but got %d", len(testCases[i].Expected), len(actual.ErrorFields))
+			}
+
+			for j := 0; j < len(testCases[i].Expected); j++ {
+				if testCases[i].Expected[j] != actual.ErrorFields[j] {
+					t.Errorf("expected error field is %s, but got %s", testCases[i].Expected[j].String(), actual.ErrorFields[j].String())
+				}
+			}
+		})
+	}
+}
+
+func TestError_FieldDensity(t *testing.T) {
+	var actual float64 = New(400, "bad request", NewErrorField("field1", "field is required"), NewErrorField("field2", "min value is 50")).FieldDensity()
+
+	if actual != 2 {
+		t.Errorf("expected field density is %f, but got %f", 2.0, actual)
+	}
+}
+
+func TestAverageFieldCount(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Errors   []error
+		Expected float64
+	}{
+		{
+			Name:     "no errors",
+			Errors:   nil,
+			Expected: 0,
+		},
+		{
+			Name:     "no custom errors",
+			Errors:   []error{errors.New("some error"), nil},
+			Expected: 0,
+		},
+		{
+			Name: "mix of errors",
+			Errors: []error{
+				New(500, "internal server error"),
+				errors.New("some error"),
+				New(400, "bad request", NewErrorField("field1", "field is required")),
+				New(
+					400,
+					"bad request",
+					NewErrorField("field1", "field is required"),
+					NewErrorField("field2", "min value is 50"),
+					NewErrorField("field3", "invalid format"),
+				),
+				nil,
+			},
+			Expected: 4.0 / 3.0,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual float64 = AverageFieldCount(testCases[i].Errors)
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected average field count is %f, but got %f", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestNewF(t *testing.T) {
+	var (
+		expected Error = New(
+			422,
+			"bad",
+			NewErrorField("email", "invalid"),
+			NewErrorField("age", "too low"),
+			NewErrorField("name", "is required"),
+		)
+		actual Error = NewF(422, "bad", F{"email", "invalid"}, F{"age", "too low"}, F{"name", "is required"})
+	)
+
+	if !expected.EqualIgnoring(actual) {
+		t.Errorf("expected error is %s, but got %s", expected.String(), actual.String())
+	}
+}
+
+func TestFirstFailure(t *testing.T) {
+	var (
+		thirdRun   bool
+		validators []func() (string, string, bool)
+	)
+
+	validators = []func() (string, string, bool){
+		func() (string, string, bool) {
+			return "name", "", true
+		},
+		func() (string, string, bool) {
+			return "email", "email is invalid", false
+		},
+		func() (string, string, bool) {
+			thirdRun = true
+			return "age", "age is too low", false
+		},
+	}
+
+	actualErr, actualFailed := FirstFailure(400, "bad request", validators...)
+
+	if !actualFailed {
+		t.Errorf("expected failed is %t, but got %t", true, actualFailed)
+	}
+
+	if thirdRun {
+		t.Errorf("expected third validator is not run")
+	}
+
+	if !New(400, "bad request", NewErrorField("email", "email is invalid")).EqualIgnoring(actualErr) {
+		t.Errorf("expected error is [400] bad request {email: email is invalid}, but got %s", actualErr.String())
+	}
+
+	actualErr, actualFailed = FirstFailure(400, "bad request", validators[0])
+
+	if actualFailed {
+		t.Errorf("expected failed is %t, but got %t", false, actualFailed)
+	}
+
+	if !(Error{}).EqualIgnoring(actualErr) {
+		t.Errorf("expected empty error, but got %s", actualErr.String())
+	}
+}
+
+func TestError_CoversFields(t *testing.T) {
+	var customError Error = New(
+		400,
+		"bad request",
+		NewErrorField("field1", "field is required"),
+		NewErrorField("field2", "field is required"),
+	)
+
+	testCases := []struct {
+		Name     string
+		Required []string
+		Expected bool
+	}{
+		{
+			Name:     "full coverage",
+			Required: []string{"field1", "field2"},
+			Expected: true,
+		},
+		{
+			Name:     "partial coverage",
+			Required: []string{"field1", "field3"},
+			Expected: false,
+		},
+		{
+			Name:     "empty required set",
+			Required: nil,
+			Expected: true,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = customError.CoversFields(testCases[i].Required...)
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected covers fields is %t, but got %t", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestError_FieldsRef(t *testing.T) {
+	var customError Error = New(400, "bad request", NewErrorField("field1", "field is required"))
+
+	if &customError.FieldsRef()[0] != &customError.ErrorFields[0] {
+		t.Errorf("expected fields ref shares the error fields slice")
+	}
+}
+
+func TestGo(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Fn       func() error
+		Expected error
+	}{
+		{
+			Name: "returned error",
+			Fn: func() error {
+				return errors.New("some error")
+			},
+			Expected: errors.New("some error"),
+		},
+		{
+			Name: "panic",
+			Fn: func() error {
+				panic("something went wrong")
+			},
+			Expected: New(http.StatusInternalServerError, "panic: something went wrong"),
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				errCh  chan error = make(chan error, 1)
+				actual error
+			)
+
+			Go(testCases[i].Fn, errCh, http.StatusInternalServerError)
+			actual = <-errCh
+
+			if testCases[i].Expected.Error() != actual.Error() {
+				t.Errorf("expected error message is %s, but got %s", testCases[i].Expected.Error(), actual.Error())
+			}
+
+			if GetErrorCode(testCases[i].Expected) != GetErrorCode(actual) {
+				t.Errorf("expected error code is %d, but got %d", GetErrorCode(testCases[i].Expected), GetErrorCode(actual))
+			}
+		})
+	}
+}
+
+func TestCatchValidation(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Fn       func()
+		Expected struct {
+			CustomError Error
+			IsCaught    bool
+		}
+	}{
+		{
+			Name: "custom error panic",
+			Fn: func() {
+				panic(New(http.StatusBadRequest, "bad request", NewErrorField("email", "invalid format")))
+			},
+			Expected: struct {
+				CustomError Error
+				IsCaught    bool
+			}{
+				CustomError: New(http.StatusBadRequest, "bad request", NewErrorField("email", "invalid format")),
+				IsCaught:    true,
+			},
+		},
+		{
+			Name: "no panic",
+			Fn:   func() {},
+			Expected: struct {
+				CustomError Error
+				IsCaught    bool
+			}{
+				CustomError: Error{},
+				IsCaught:    false,
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			actual, isCaught := CatchValidation(testCases[i].Fn)
+
+			if testCases[i].Expected.IsCaught != isCaught {
+				t.Errorf("expected is caught is %t, but got %t", testCases[i].Expected.IsCaught, isCaught)
+			}
+
+			if !testCases[i].Expected.CustomError.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Expected.CustomError.String(), actual.String())
+			}
+		})
+	}
+
+	t.Run("foreign panic is re-raised", func(t *testing.T) {
+		defer func() {
+			var recovered any = recover()
+			if recovered != "something went wrong" {
+				t.Errorf("expected re-raised panic is %v, but got %v", "something went wrong", recovered)
+			}
+		}()
+
+		_, _ = CatchValidation(func() {
+			panic("something went wrong")
+		})
+
+		t.Errorf("expected panic to be re-raised")
+	})
+}
+
+func TestError_SortedFieldNames(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Expected []string
+	}{
+		{
+			Name:     "no error fields",
+			Error:    New(500, "internal server error"),
+			Expected: []string{},
+		},
+		{
+			Name: "duplicates and mixed case",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("name", "field is required"),
+				NewErrorField("Email", "email is invalid"),
+				NewErrorField("email", "email is required"),
+				NewErrorField("name", "min length is 3"),
+			),
+			Expected: []string{"Email", "email", "name"},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []string = testCases[i].Error.SortedFieldNames()
+
+			if len(testCases[i].Expected) != len(actual) {
+				t.Fatalf("expected length of field names is %d, but got %d", len(testCases[i].Expected), len(actual))
+			}
+
+			for j := 0; j < len(testCases[i].Expected); j++ {
+				if testCases[i].Expected[j] != actual[j] {
+					t.Errorf("expected field name is %s, but got %s", testCases[i].Expected[j], actual[j])
+				}
+			}
+		})
+	}
+}
+
+func TestCause(t *testing.T) {
+	var readErr error = fmt.Errorf("read body: %w", io.EOF)
+
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected struct {
+			HasCause  bool
+			RootCause error
+		}
+	}{
+		{
+			Name:  "error without cause",
+			Error: New(500, "internal server error"),
+			Expected: struct {
+				HasCause  bool
+				RootCause error
+			}{
+				HasCause:  false,
+				RootCause: nil,
+			},
+		},
+		{
+			Name:  "error with cause",
+			Error: Wrap(502, "bad gateway", readErr),
+			Expected: struct {
+				HasCause  bool
+				RootCause error
+			}{
+				HasCause:  true,
+				RootCause: io.EOF,
+			},
+		},
+		{
+			Name:  "wrapped error with nested custom cause",
+			Error: fmt.Errorf("handler: %w", Wrap(500, "internal server error", Wrap(502, "bad gateway", io.EOF))),
+			Expected: struct {
+				HasCause  bool
+				RootCause error
+			}{
+				HasCause:  true,
+				RootCause: io.EOF,
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualHasCause  bool  = HasCause(testCases[i].Error)
+				actualRootCause error = RootCause(testCases[i].Error)
+			)
+
+			if testCases[i].Expected.HasCause != actualHasCause {
+				t.Errorf("expected has cause is %t, but got %t", testCases[i].Expected.HasCause, actualHasCause)
+			}
+
+			if testCases[i].Expected.RootCause != actualRootCause {
+				t.Errorf("expected root cause is %v, but got %v", testCases[i].Expected.RootCause, actualRootCause)
+			}
+		})
+	}
+}
+
+func TestWrap(t *testing.T) {
+	var (
+		cause   error = errors.New("connection refused")
+		wrapped Error = Wrap(503, "service unavailable", cause, NewErrorField("field1", "field is required"))
+	)
+
+	if wrapped.Code != 503 || wrapped.Message != "service unavailable" {
+		t.Errorf("expected error is [503] service unavailable, but got [%d] %s", wrapped.Code, wrapped.Message)
+	}
+
+	if len(wrapped.ErrorFields) != 1 {
+		t.Errorf("expected length of error fields is %d, but got %d", 1, len(wrapped.ErrorFields))
+	}
+
+	if wrapped.Cause != cause {
+		t.Errorf("expected cause is %v, but got %v", cause, wrapped.Cause)
+	}
+}
+
+func TestError_Unwrap(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Target   error
+		Expected bool
+	}{
+		{
+			Name:     "no cause",
+			Error:    New(500, "internal server error"),
+			Target:   io.EOF,
+			Expected: false,
+		},
+		{
+			Name:     "wrapped io.EOF",
+			Error:    Wrap(500, "internal server error", io.EOF),
+			Target:   io.EOF,
+			Expected: true,
+		},
+		{
+			Name:     "wrapped io.EOF inside another wrap",
+			Error:    Wrap(502, "bad gateway", fmt.Errorf("read body: %w", io.EOF)),
+			Target:   io.EOF,
+			Expected: true,
+		},
+		{
+			Name:     "wrapped other error",
+			Error:    Wrap(500, "internal server error", io.ErrUnexpectedEOF),
+			Target:   io.EOF,
+			Expected: false,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = errors.Is(testCases[i].Error, testCases[i].Target)
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected errors is is %t, but got %t", testCases[i].Expected, actual)
+			}
+		})
+	}
+
+	if errors.Unwrap(Wrap(500, "internal server error", io.EOF)) != io.EOF {
+		t.Errorf("expected unwrapped error is %v", io.EOF)
+	}
+}
+
+func TestError_IsEmpty(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Expected bool
+	}{
+		{
+			Name:     "zero value",
+			Error:    Error{},
+			Expected: true,
+		},
+		{
+			Name:     "only code",
+			Error:    New(404, ""),
+			Expected: false,
+		},
+		{
+			Name:     "only message",
+			Error:    New(0, "some error"),
+			Expected: false,
+		},
+		{
+			Name:     "only error fields",
+			Error:    New(0, "", NewErrorField("field1", "field is required")),
+			Expected: false,
+		},
+		{
+			Name:     "only cause",
+			Error:    Wrap(0, "", io.EOF),
+			Expected: false,
+		},
+		{
+			Name:     "only message key",
+			Error:    Error{MessageKey: "errors.bad_request"},
+			Expected: false,
+		},
+		{
+			Name:     "only trace id",
+			Error:    Error{}.WithTraceID("req-123"),
+			Expected: false,
+		},
+		{
+			Name:     "only timestamp",
+			Error:    Error{}.WithTimestamp(time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC)),
+			Expected: false,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = testCases[i].Error.IsEmpty()
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected is empty is %t, but got %t", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestError_OrElse(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Fallback Error
+		Expected Error
+	}{
+		{
+			Name:     "empty receiver",
+			Error:    Error{},
+			Fallback: New(500, "internal server error"),
+			Expected: New(500, "internal server error"),
+		},
+		{
+			Name:     "non empty receiver",
+			Error:    New(404, "not found"),
+			Fallback: New(500, "internal server error"),
+			Expected: New(404, "not found"),
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual Error = testCases[i].Error.OrElse(testCases[i].Fallback)
+
+			if !testCases[i].Expected.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Expected.String(), actual.String())
+			}
+		})
+	}
+}
+
+func TestIsCodeOnly(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected bool
+	}{
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New(""),
+			Expected: false,
+		},
+		{
+			Name:     "code only",
+			Error:    New(404, ""),
+			Expected: true,
+		},
+		{
+			Name:     "code with message",
+			Error:    New(404, "not found"),
+			Expected: false,
+		},
+		{
+			Name:     "code with error fields",
+			Error:    New(400, "", NewErrorField("field1", "field is required")),
+			Expected: false,
+		},
+		{
+			Name:     "zero value",
+			Error:    Error{},
+			Expected: false,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = IsCodeOnly(testCases[i].Error)
+
+			if testCases[i].Expected != actual {
+				t.Errorf("expected is code only is %t, but got %t", testCases[i].Expected, actual)
+			}
+		})
+	}
+}
+
+func TestError_WithField(t *testing.T) {
+	var (
+		original Error = New(400, "bad request", NewErrorField("field1", "field is required"))
+		expected Error = New(
+			400,
+			"bad request",
+			NewErrorField("field1", "field is required"),
+			NewErrorField("field2", "min value is 50"),
+		)
+		actual Error
+	)
+
+	actual = original.WithField("field2", "min value is 50")
+
+	if !expected.EqualIgnoring(actual) {
+		t.Errorf("expected error is %s, but got %s", expected.String(), actual.String())
+	}
+
+	if len(original.ErrorFields) != 1 {
+		t.Errorf("expected length of original error fields is %d, but got %d", 1, len(original.ErrorFields))
+	}
+}
+
+func TestError_WithFields(t *testing.T) {
+	var (
+		original Error = New(400, "bad request", make([]ErrorField, 1, 4)...)
+		first    Error
+		second   Error
+	)
+
+	first = original.WithFields(NewErrorField("field1", "field is required"), NewErrorField("field2", "min value is 50"))
+	second = original.WithFields(NewErrorField("field3", "invalid format"))
+
+	if len(original.ErrorFields) != 1 {
+		t.Errorf("expected length of original error fields is %d, but got %d", 1, len(original.ErrorFields))
+	}
+
+	if len(first.ErrorFields) != 3 || first.ErrorFields[1].Field != "field1" || first.ErrorFields[2].Field != "field2" {
+		t.Errorf("expected first error fields are appended, but got %v", first.ErrorFields)
+	}
+
+	if len(second.ErrorFields) != 2 || second.ErrorFields[1].Field != "field3" {
+		t.Errorf("expected second error fields are appended, but got %v", second.ErrorFields)
+	}
+}
+
+func TestError_EscalateIfFields(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Expected Error
+	}{
+		{
+			Name:     "error with error fields",
+			Error:    New(200, "ok", NewErrorField("email", "invalid format")),
+			Expected: New(422, "ok", NewErrorField("email", "invalid format")),
+		},
+		{
+			Name:     "error without error fields",
+			Error:    New(200, "ok"),
+			Expected: New(200, "ok"),
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual Error = testCases[i].Error.EscalateIfFields(422)
+
+			if !testCases[i].Expected.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Expected.String(), actual.String())
+			}
+
+			if testCases[i].Error.Code != 200 {
+				t.Errorf("expected original code is %d, but got %d", 200, testCases[i].Error.Code)
+			}
+		})
+	}
+}
+
+func TestError_FieldsConform(t *testing.T) {
+	var snakeCase *regexp.Regexp = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+	testCases := []struct {
+		Name     string
+		Error    Error
+		Expected struct {
+			Conform       bool
+			NonConforming []string
+		}
+	}{
+		{
+			Name: "conforming field names",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("user_name", "field is required"),
+				NewErrorField("age", "min value is 18"),
+			),
+			Expected: struct {
+				Conform       bool
+				NonConforming []string
+			}{
+				Conform:       true,
+				NonConforming: nil,
+			},
+		},
+		{
+			Name: "non-conforming field names",
+			Error: New(
+				400,
+				"bad request",
+				NewErrorField("userName", "field is required"),
+				NewErrorField("age", "min value is 18"),
+				NewErrorField("first name", "field is required"),
+				NewErrorField("userName", "invalid format"),
+			),
+			Expected: struct {
+				Conform       bool
+				NonConforming []string
+			}{
+				Conform:       false,
+				NonConforming: []string{"userName", "first name"},
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			conform, nonConforming := testCases[i].Error.FieldsConform(snakeCase)
+
+			if testCases[i].Expected.Conform != conform {
+				t.Errorf("expected conform is %t, but got %t", testCases[i].Expected.Conform, conform)
+			}
+
+			if len(testCases[i].Expected.NonConforming) != len(nonConforming) {
+				t.Fatalf("expected non-conforming names are %v, but got %v", testCases[i].Expected.NonConforming, nonConforming)
+			}
+
+			for j := 0; j < len(testCases[i].Expected.NonConforming); j++ {
+				if testCases[i].Expected.NonConforming[j] != nonConforming[j] {
+					t.Errorf("expected non-conforming name is %s, but got %s", testCases[i].Expected.NonConforming[j], nonConforming[j])
+				}
+			}
+		})
+	}
+}
+
+func TestRangeFields(t *testing.T) {
+	var customError Error = New(
+		400,
+		"bad request",
+		NewErrorField("field1", "field is required"),
+		NewErrorField("field2", "min value is 50"),
+		NewErrorField("field3", "invalid format"),
+	)
+
+	testCases := []struct {
+		Name     string
+		Error    error
+		Limit    int
+		Expected []string
+	}{
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Limit:    3,
+			Expected: nil,
+		},
+		{
+			Name:     "consume every field",
+			Error:    customError,
+			Limit:    3,
+			Expected: []string{"field1", "field2", "field3"},
+		},
+		{
+			Name:     "break early",
+			Error:    fmt.Errorf("handler: %w", customError),
+			Limit:    2,
+			Expected: []string{"field1", "field2"},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []string
+
+			for errorField := range RangeFields(testCases[i].Error) {
+				actual = append(actual, errorField.Field)
+				if len(actual) == testCases[i].Limit {
+					break
+				}
+			}
+
+			if len(testCases[i].Expected) != len(actual) {
+				t.Fatalf("expected length of fields is %d, but got %d", len(testCases[i].Expected), len(actual))
+			}
+
+			for j := 0; j < len(testCases[i].Expected); j++ {
+				if testCases[i].Expected[j] != actual[j] {
+					t.Errorf("expected field is %s, but got %s", testCases[i].Expected[j], actual[j])
+				}
+			}
+		})
+	}
+}
+
+type requestIDKey struct{}
+
+func TestError_WithContextID(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Context  context.Context
+		Expected Error
+	}{
+		{
+			Name:     "context with id",
+			Context:  context.WithValue(context.Background(), requestIDKey{}, "req-123"),
+			Expected: New(400, "bad request").WithTraceID("req-123"),
+		},
+		{
+			Name:     "context without id",
+			Context:  context.Background(),
+			Expected: New(400, "bad request").WithTraceID("req-000"),
+		},
+	}
+
+	ContextID = func(ctx context.Context) string {
+		var id string
+
+		id, _ = ctx.Value(requestIDKey{}).(string)
+
+		return id
+	}
+	defer func() {
+		ContextID = nil
+	}()
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual Error = New(400, "bad request").WithTraceID("req-000").WithContextID(testCases[i].Context)
+
+			if !testCases[i].Expected.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Expected.String(), actual.String())
+			}
+		})
+	}
+}
+
+func TestOnNew(t *testing.T) {
+	var (
+		count   int
+		causes  int
+		data    []byte
+		decoded Error
+	)
+
+	data, _ = New(400, "bad request", NewErrorField("field1", "field is required")).MarshalBinary()
+
+	OnNew = func(err Error) {
+		count++
+		if err.Cause != nil {
+			causes++
+		}
+	}
+	defer func() {
+		OnNew = nil
+	}()
+
+	_ = New(400, "bad request")
+	_ = Wrap(500, "internal server error", io.EOF)
+	_ = NewF(422, "bad", F{"email", "invalid"})
+	_ = NewFromCode(404)
+	_ = NewBuilder(400, "bad request").AddField("field1", "field is required").Build()
+
+	if count != 5 {
+		t.Errorf("expected hook calls is %d, but got %d", 5, count)
+	}
+
+	if causes != 1 {
+		t.Errorf("expected hook calls with cause is %d, but got %d", 1, causes)
+	}
+
+	_ = decoded.UnmarshalBinary(data)
+	_ = decoded.UnmarshalJSON([]byte(`{"code":400,"message":"bad request"}`))
+
+	if count != 5 {
+		t.Errorf("expected hook calls after decoding is %d, but got %d", 5, count)
+	}
+
+	OnNew = nil
+	_ = New(400, "bad request")
+
+	if count != 5 {
+		t.Errorf("expected hook calls after removal is %d, but got %d", 5, count)
+	}
+}
+
+func TestError_WithoutField(t *testing.T) {
+	var original Error = New(
+		400,
+		"bad request",
+		NewErrorField("field1", "field is required"),
+		NewErrorField("field2", "min value is 50"),
+		NewErrorField("field1", "invalid format"),
+	)
+
+	testCases := []struct {
+		Name     string
+		Field    string
+		Expected Error
+	}{
+		{
+			Name:     "existing field",
+			Field:    "field2",
+			Expected: New(400, "bad request", NewErrorField("field1", "field is required"), NewErrorField("field1", "invalid format")),
+		},
+		{
+			Name:     "non existing field",
+			Field:    "field3",
+			Expected: original,
+		},
+		{
+			Name:     "field appears multiple times",
+			Field:    "field1",
+			Expected: New(400, "bad request", NewErrorField("field2", "min value is 50")),
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual Error = original.WithoutField(testCases[i].Field)
+
+			if !testCases[i].Expected.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Expected.String(), actual.String())
+			}
+
+			if len(original.ErrorFields) != 3 {
+				t.Errorf("expected length of original error fields is %d, but got %d", 3, len(original.ErrorFields))
+			}
+		})
+	}
+}
+
+func TestError_WithFieldPrefix(t *testing.T) {
+	var (
+		original Error = New(
+			400,
+			"bad request",
+			NewErrorField("email", "email format"),
+			NewErrorField("age", "age value"),
+		)
+		expected Error = New(
+			400,
+			"bad request",
+			NewErrorField("email", "Invalid: email format"),
+			NewErrorField("age", "Invalid: age value"),
+		)
+		actual Error = original.WithFieldPrefix("Invalid:")
+	)
+
+	if !expected.EqualIgnoring(actual) {
+		t.Errorf("expected error is %s, but got %s", expected.String(), actual.String())
+	}
+
+	if original.ErrorFields[0].Message != "email format" || original.ErrorFields[1].Message != "age value" {
+		t.Errorf("expected original error fields are unchanged, but got %v", original.ErrorFields)
+	}
+}
+
+func TestError_KeepLongestMessages(t *testing.T) {
+	var (
+		original Error = New(
+			400,
+			"bad request",
+			NewErrorField("email", "invalid"),
+			NewErrorField("age", "min value is 18"),
+			NewErrorField("email", "must be a valid email address"),
+			NewErrorField("age", "too low"),
+		)
+		expected Error = New(
+			400,
+			"bad request",
+			NewErrorField("email", "must be a valid email address"),
+			NewErrorField("age", "min value is 18"),
+		)
+		actual Error = original.KeepLongestMessages()
+	)
+
+	if !expected.EqualIgnoring(actual) {
+		t.Errorf("expected error is %s, but got %s", expected.String(), actual.String())
+	}
+
+	if len(original.ErrorFields) != 4 || original.ErrorFields[0].Message != "invalid" {
+		t.Errorf("expected original error fields are unchanged, but got %v", original.ErrorFields)
+	}
+}
+
+func TestError_LimitFields(t *testing.T) {
+	var original Error = New(
+		400,
+		"bad request",
+		NewErrorField("field1", "field is required"),
+		NewErrorField("field2", "min value is 50"),
+		NewErrorField("field3", "invalid format"),
+	)
+
+	testCases := []struct {
+		Name     string
+		Max      int
+		Expected Error
+	}{
+		{
+			Name:     "fewer fields than limit",
+			Max:      5,
+			Expected: original,
+		},
+		{
+			Name:     "as many fields as limit",
+			Max:      3,
+			Expected: original,
+		},
+		{
+			Name: "more fields than limit",
+			Max:  1,
+			Expected: New(
+				400,
+				"bad request",
+				NewErrorField("field1", "field is required"),
+				NewErrorField(OverflowField, "and 2 more"),
+			),
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual Error = original.LimitFields(testCases[i].Max)
+
+			if !testCases[i].Expected.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Expected.String(), actual.String())
+			}
+
+			if len(original.ErrorFields) != 3 {
+				t.Errorf("expected length of original error fields is %d, but got %d", 3, len(original.ErrorFields))
+			}
+		})
+	}
+}
+
+func TestError_AppendFieldsFrom(t *testing.T) {
+	var other Error = New(
+		400,
+		"bad request",
+		NewErrorField("email", "invalid format"),
+		NewErrorField("age", "min value is 18"),
+		NewErrorField("name", "is required"),
+	)
+
+	testCases := []struct {
+		Name       string
+		Other      error
+		FieldNames []string
+		Expected   Error
+	}{
+		{
+			Name:       "append subset of fields",
+			Other:      other,
+			FieldNames: []string{"email", "name"},
+			Expected: New(
+				422,
+				"unprocessable entity",
+				NewErrorField("address", "is required"),
+				NewErrorField("email", "invalid format"),
+				NewErrorField("name", "is required"),
+			),
+		},
+		{
+			Name:  "append all fields",
+			Other: other,
+			Expected: New(
+				422,
+				"unprocessable entity",
+				NewErrorField("address", "is required"),
+				NewErrorField("email", "invalid format"),
+				NewErrorField("age", "min value is 18"),
+				NewErrorField("name", "is required"),
+			),
+		},
+		{
+			Name:     "other is not custom error",
+			Other:    errors.New("some error"),
+			Expected: New(422, "unprocessable entity", NewErrorField("address", "is required")),
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				original Error = New(422, "unprocessable entity", NewErrorField("address", "is required"))
+				actual   Error = original.AppendFieldsFrom(testCases[i].Other, testCases[i].FieldNames...)
+			)
+
+			if !testCases[i].Expected.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Expected.String(), actual.String())
+			}
+
+			if len(original.ErrorFields) != 1 {
+				t.Errorf("expected length of original error fields is %d, but got %d", 1, len(original.ErrorFields))
+			}
+		})
+	}
+}
+
+func TestErrorsAs(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected struct {
+			CustomError Error
+			Found       bool
+		}
+	}{
+		{
+			Name:  "error is not custom error",
+			Error: fmt.Errorf("context: %w", errors.New("some error")),
+		},
+		{
+			Name:  "direct custom error",
+			Error: New(400, "bad request", NewErrorField("field1", "field is required")),
+			Expected: struct {
+				CustomError Error
+				Found       bool
+			}{
+				CustomError: New(400, "bad request", NewErrorField("field1", "field is required")),
+				Found:       true,
+			},
+		},
+		{
+			Name:  "custom error wrapped with %w",
+			Error: fmt.Errorf("context: %w", New(400, "bad request", NewErrorField("field1", "field is required"))),
+			Expected: struct {
+				CustomError Error
+				Found       bool
+			}{
+				CustomError: New(400, "bad request", NewErrorField("field1", "field is required")),
+				Found:       true,
+			},
+		},
+		{
+			Name:  "custom error wrapped twice with %w",
+			Error: fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", New(404, "not found"))),
+			Expected: struct {
+				CustomError Error
+				Found       bool
+			}{
+				CustomError: New(404, "not found"),
+				Found:       true,
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualCustomError Error
+				actualFound       bool
+			)
+
+			actualFound = errors.As(testCases[i].Error, &actualCustomError)
+
+			if testCases[i].Expected.Found != actualFound {
+				t.Errorf("expected found is %t, but got %t", testCases[i].Expected.Found, actualFound)
+			}
+
+			if !testCases[i].Expected.CustomError.EqualIgnoring(actualCustomError) {
+				t.Errorf("expected custom error is %s, but got %s", testCases[i].Expected.CustomError.String(), actualCustomError.String())
+			}
+		})
+	}
+}
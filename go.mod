@@ -1,3 +1,3 @@
 module github.com/fikri240794/gocerr
 
-go 1.18
+go 1.23
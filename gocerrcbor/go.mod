@@ -0,0 +1,10 @@
+module github.com/fikri240794/gocerr/gocerrcbor
+
+go 1.23
+
+require (
+	github.com/fikri240794/gocerr v0.0.0-20261016011506-ac4f84ed02d4
+	github.com/fxamacker/cbor/v2 v2.9.0
+)
+
+require github.com/x448/float16 v0.8.4 // indirect
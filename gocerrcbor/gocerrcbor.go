@@ -0,0 +1,63 @@
+package gocerrcbor
+
+import (
+	"github.com/fikri240794/gocerr"
+	"github.com/fxamacker/cbor/v2"
+)
+
+type errorCBOR struct {
+	Code        int              `cbor:"code"`
+	Message     string           `cbor:"message"`
+	ErrorFields []errorFieldCBOR `cbor:"error_fields,omitempty"`
+	TraceID     string           `cbor:"trace_id,omitempty"`
+}
+
+type errorFieldCBOR struct {
+	Field   string `cbor:"field"`
+	Code    string `cbor:"code,omitempty"`
+	Message string `cbor:"message"`
+}
+
+// Marshal encodes e as a CBOR map with the same keys as its JSON form: code,
+// message, error_fields and trace_id, the latter two omitted when empty.
+func Marshal(e gocerr.Error) ([]byte, error) {
+	var errCBOR errorCBOR = errorCBOR{
+		Code:    e.Code,
+		Message: e.Message,
+		TraceID: e.TraceID,
+	}
+
+	for i := 0; i < len(e.ErrorFields); i++ {
+		errCBOR.ErrorFields = append(errCBOR.ErrorFields, errorFieldCBOR{
+			Field:   e.ErrorFields[i].Field,
+			Code:    e.ErrorFields[i].Code,
+			Message: e.ErrorFields[i].Message,
+		})
+	}
+
+	return cbor.Marshal(errCBOR)
+}
+
+func Unmarshal(data []byte) (gocerr.Error, error) {
+	var (
+		errCBOR     errorCBOR
+		err         error
+		errorFields []gocerr.ErrorField
+	)
+
+	err = cbor.Unmarshal(data, &errCBOR)
+	if err != nil {
+		return gocerr.Error{}, err
+	}
+
+	for i := 0; i < len(errCBOR.ErrorFields); i++ {
+		errorFields = append(errorFields, gocerr.NewErrorFieldWithCode(errCBOR.ErrorFields[i].Field, errCBOR.ErrorFields[i].Code, errCBOR.ErrorFields[i].Message))
+	}
+
+	return gocerr.Error{
+		Code:        errCBOR.Code,
+		Message:     errCBOR.Message,
+		ErrorFields: errorFields,
+		TraceID:     errCBOR.TraceID,
+	}, nil
+}
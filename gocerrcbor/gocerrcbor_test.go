@@ -0,0 +1,115 @@
+package gocerrcbor
+
+import (
+	"testing"
+
+	"github.com/fikri240794/gocerr"
+	"github.com/fxamacker/cbor/v2"
+)
+
+func TestMarshalUnmarshal(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Error gocerr.Error
+	}{
+		{
+			Name:  "no error fields",
+			Error: gocerr.New(500, "internal server error"),
+		},
+		{
+			Name: "multiple error fields",
+			Error: gocerr.New(
+				400,
+				"bad request",
+				gocerr.NewErrorField("field1", "field is required"),
+				gocerr.NewErrorField("field2", "min value is 50"),
+			),
+		},
+		{
+			Name: "error fields with code",
+			Error: gocerr.New(
+				400,
+				"bad request",
+				gocerr.NewErrorFieldWithCode("field1", "required", "field is required"),
+			),
+		},
+		{
+			Name:  "with trace id",
+			Error: gocerr.New(500, "internal server error").WithTraceID("req-123"),
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			data, err := Marshal(testCases[i].Error)
+			if err != nil {
+				t.Fatalf("expected no marshal error, but got %v", err)
+			}
+
+			actual, err := Unmarshal(data)
+			if err != nil {
+				t.Fatalf("expected no unmarshal error, but got %v", err)
+			}
+
+			if !testCases[i].Error.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Error.String(), actual.String())
+			}
+		})
+	}
+}
+
+func TestMarshal_Keys(t *testing.T) {
+	var (
+		data    []byte
+		decoded map[string]any
+		err     error
+	)
+
+	data, err = Marshal(gocerr.New(400, "bad request", gocerr.NewErrorField("field1", "field is required")).WithTraceID("req-123"))
+	if err != nil {
+		t.Fatalf("expected no marshal error, but got %v", err)
+	}
+
+	err = cbor.Unmarshal(data, &decoded)
+	if err != nil {
+		t.Fatalf("expected no unmarshal error, but got %v", err)
+	}
+
+	for _, key := range []string{"code", "message", "error_fields", "trace_id"} {
+		if _, exists := decoded[key]; !exists {
+			t.Errorf("expected key %s exists", key)
+		}
+	}
+}
+
+func TestUnmarshal_InvalidData(t *testing.T) {
+	_, err := Unmarshal([]byte{0xff})
+	if err == nil {
+		t.Errorf("expected unmarshal error, but got nil")
+	}
+}
+
+func TestUnmarshal_OnNew(t *testing.T) {
+	var count int
+
+	data, err := Marshal(gocerr.New(400, "bad request"))
+	if err != nil {
+		t.Fatalf("expected no marshal error, but got %v", err)
+	}
+
+	gocerr.OnNew = func(gocerr.Error) {
+		count++
+	}
+	defer func() {
+		gocerr.OnNew = nil
+	}()
+
+	_, err = Unmarshal(data)
+	if err != nil {
+		t.Fatalf("expected no unmarshal error, but got %v", err)
+	}
+
+	if count != 0 {
+		t.Errorf("expected hook calls is %d, but got %d", 0, count)
+	}
+}
@@ -0,0 +1,14 @@
+module github.com/fikri240794/gocerr/gocerrgrpc
+
+go 1.25.0
+
+require (
+	github.com/fikri240794/gocerr v0.0.0-20261016011506-ac4f84ed02d4
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260706201446-f0a921348800
+	google.golang.org/grpc v1.84.0
+)
+
+require (
+	golang.org/x/sys v0.47.0 // indirect
+	google.golang.org/protobuf v1.36.11 // indirect
+)
@@ -0,0 +1,237 @@
+package gocerrgrpc
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/fikri240794/gocerr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// CodeMapping maps custom error codes to gRPC codes for ToStatus and, in
+// reverse, FromStatus. The default mapping is:
+//
+//	400 Bad Request            InvalidArgument
+//	401 Unauthorized           Unauthenticated
+//	403 Forbidden              PermissionDenied
+//	404 Not Found              NotFound
+//	409 Conflict               AlreadyExists
+//	412 Precondition Failed    FailedPrecondition
+//	422 Unprocessable Entity   InvalidArgument
+//	429 Too Many Requests      ResourceExhausted
+//	499 Client Closed Request  Canceled
+//	500 Internal Server Error  Internal
+//	501 Not Implemented        Unimplemented
+//	502 Bad Gateway            Unavailable
+//	503 Service Unavailable    Unavailable
+//	504 Gateway Timeout        DeadlineExceeded
+//
+// Codes missing from the mapping become Unknown, and gRPC codes missing from
+// it become 500. When several codes map to the same gRPC code, the reverse
+// lookup picks the lowest one; ToStatus then records the exact code in an
+// ErrorInfo detail so that FromStatus still restores it. Configure it during
+// initialization.
+var CodeMapping map[int]codes.Code = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusUnauthorized:        codes.Unauthenticated,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusConflict:            codes.AlreadyExists,
+	http.StatusPreconditionFailed:  codes.FailedPrecondition,
+	http.StatusUnprocessableEntity: codes.InvalidArgument,
+	http.StatusTooManyRequests:     codes.ResourceExhausted,
+	499:                            codes.Canceled,
+	http.StatusInternalServerError: codes.Internal,
+	http.StatusNotImplemented:      codes.Unimplemented,
+	http.StatusBadGateway:          codes.Unavailable,
+	http.StatusServiceUnavailable:  codes.Unavailable,
+	http.StatusGatewayTimeout:      codes.DeadlineExceeded,
+}
+
+const (
+	errorInfoDomain string = "gocerr"
+	errorInfoReason string = "ERROR_CODE"
+	errorInfoCode   string = "code"
+)
+
+// fromGRPCCode returns the lowest custom code CodeMapping maps to grpcCode, or
+// 500 when there is none.
+func fromGRPCCode(grpcCode codes.Code) int {
+	var (
+		code  int = http.StatusInternalServerError
+		found bool
+	)
+
+	for customCode, mappedCode := range CodeMapping {
+		if mappedCode == grpcCode && (!found || customCode < code) {
+			code = customCode
+			found = true
+		}
+	}
+
+	return code
+}
+
+// ToMetadata returns err as gRPC trailer metadata: x-error-code and
+// x-error-message, plus one x-error-field-<n> entry per error field
+// (0-indexed) holding "field: message". Values are percent-encoded like
+// grpc-message, since gRPC only accepts printable ASCII in non-binary
+// metadata. It returns empty metadata when err is not a custom error.
+func ToMetadata(err error) metadata.MD {
+	var (
+		customError   gocerr.Error
+		isCustomError bool
+		md            metadata.MD = metadata.MD{}
+	)
+
+	customError, isCustomError = gocerr.Parse(err)
+	if !isCustomError {
+		return md
+	}
+
+	md.Set("x-error-code", strconv.Itoa(customError.Code))
+	md.Set("x-error-message", encodeMetadataValue(customError.Message))
+
+	for i := 0; i < len(customError.ErrorFields); i++ {
+		md.Set("x-error-field-"+strconv.Itoa(i), encodeMetadataValue(customError.ErrorFields[i].String()))
+	}
+
+	return md
+}
+
+// encodeMetadataValue percent-encodes every byte of value outside printable
+// ASCII, as well as '%' itself, following the grpc-message encoding.
+func encodeMetadataValue(value string) string {
+	var builder strings.Builder
+
+	for i := 0; i < len(value); i++ {
+		if value[i] < 0x20 || value[i] > 0x7E || value[i] == '%' {
+			fmt.Fprintf(&builder, "%%%02X", value[i])
+			continue
+		}
+		builder.WriteByte(value[i])
+	}
+
+	return builder.String()
+}
+
+// ToStatus converts err into a gRPC status whose code comes from CodeMapping
+// and whose message is the error message. Error fields are attached as a
+// BadRequest detail, one field violation per error field with the field code
+// as reason. When the gRPC code alone does not map back to the error code, the
+// code is also attached as an ErrorInfo detail in the "gocerr" domain. Errors
+// that are not custom errors are converted with status.Convert, so nil becomes
+// a nil (OK) status.
+func ToStatus(err error) *status.Status {
+	var (
+		customError   gocerr.Error
+		isCustomError bool
+		grpcCode      codes.Code
+		mapped        bool
+		st            *status.Status
+		detailed      *status.Status
+		badRequest    *errdetails.BadRequest
+		detailsErr    error
+	)
+
+	customError, isCustomError = gocerr.Parse(err)
+	if !isCustomError {
+		return status.Convert(err)
+	}
+
+	grpcCode, mapped = CodeMapping[customError.Code]
+	if !mapped {
+		grpcCode = codes.Unknown
+	}
+
+	st = status.New(grpcCode, customError.Message)
+
+	if fromGRPCCode(grpcCode) != customError.Code {
+		detailed, detailsErr = st.WithDetails(&errdetails.ErrorInfo{
+			Reason:   errorInfoReason,
+			Domain:   errorInfoDomain,
+			Metadata: map[string]string{errorInfoCode: strconv.Itoa(customError.Code)},
+		})
+		if detailsErr == nil {
+			st = detailed
+		}
+	}
+
+	if len(customError.ErrorFields) == 0 {
+		return st
+	}
+
+	badRequest = &errdetails.BadRequest{}
+	for i := 0; i < len(customError.ErrorFields); i++ {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       customError.ErrorFields[i].Field,
+			Description: customError.ErrorFields[i].Message,
+			Reason:      customError.ErrorFields[i].Code,
+		})
+	}
+
+	detailed, detailsErr = st.WithDetails(badRequest)
+	if detailsErr != nil {
+		return st
+	}
+
+	return detailed
+}
+
+// FromStatus converts st back into a custom error. The code comes from a
+// "gocerr" ErrorInfo detail when present, otherwise from CodeMapping in
+// reverse, and BadRequest field violations become error fields. A nil or OK
+// status yields an empty error.
+func FromStatus(st *status.Status) gocerr.Error {
+	var (
+		code        int
+		details     []any
+		errorInfo   *errdetails.ErrorInfo
+		isErrorInfo bool
+		exactCode   int
+		parseErr    error
+		badRequest  *errdetails.BadRequest
+		isBadReq    bool
+		violation   *errdetails.BadRequest_FieldViolation
+		errorFields []gocerr.ErrorField
+	)
+
+	if st.Code() == codes.OK {
+		return gocerr.Error{}
+	}
+
+	code = fromGRPCCode(st.Code())
+
+	details = st.Details()
+	for i := 0; i < len(details); i++ {
+		errorInfo, isErrorInfo = details[i].(*errdetails.ErrorInfo)
+		if isErrorInfo && errorInfo.GetDomain() == errorInfoDomain {
+			exactCode, parseErr = strconv.Atoi(errorInfo.GetMetadata()[errorInfoCode])
+			if parseErr == nil {
+				code = exactCode
+			}
+			continue
+		}
+
+		badRequest, isBadReq = details[i].(*errdetails.BadRequest)
+		if !isBadReq {
+			continue
+		}
+
+		for j := 0; j < len(badRequest.GetFieldViolations()); j++ {
+			violation = badRequest.GetFieldViolations()[j]
+			errorFields = append(errorFields, gocerr.NewErrorFieldWithCode(violation.GetField(), violation.GetReason(), violation.GetDescription()))
+		}
+	}
+
+	return gocerr.Error{
+		Code:        code,
+		Message:     st.Message(),
+		ErrorFields: errorFields,
+	}
+}
@@ -0,0 +1,239 @@
+package gocerrgrpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fikri240794/gocerr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestToMetadata(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected metadata.MD
+	}{
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Expected: metadata.MD{},
+		},
+		{
+			Name: "error with error fields",
+			Error: gocerr.New(
+				400,
+				"bad request",
+				gocerr.NewErrorField("field1", "field is required"),
+				gocerr.NewErrorField("field2", "min value is 50"),
+			),
+			Expected: metadata.Pairs(
+				"x-error-code", "400",
+				"x-error-message", "bad request",
+				"x-error-field-0", "field1: field is required",
+				"x-error-field-1", "field2: min value is 50",
+			),
+		},
+		{
+			Name: "non-ascii message",
+			Error: gocerr.New(
+				400,
+				"données invalides\n100%",
+				gocerr.NewErrorField("名前", "必須"),
+			),
+			Expected: metadata.Pairs(
+				"x-error-code", "400",
+				"x-error-message", "donn%C3%A9es invalides%0A100%25",
+				"x-error-field-0", "%E5%90%8D%E5%89%8D: %E5%BF%85%E9%A0%88",
+			),
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual metadata.MD = ToMetadata(testCases[i].Error)
+
+			if len(testCases[i].Expected) != len(actual) {
+				t.Fatalf("expected length of metadata is %d, but got %d", len(testCases[i].Expected), len(actual))
+			}
+
+			for key, values := range testCases[i].Expected {
+				if len(actual.Get(key)) != 1 || actual.Get(key)[0] != values[0] {
+					t.Errorf("expected metadata %s is %v, but got %v", key, values, actual.Get(key))
+				}
+			}
+		})
+	}
+}
+
+func TestToStatus(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected struct {
+			Code    codes.Code
+			Message string
+		}
+	}{
+		{
+			Name:  "error is not custom error",
+			Error: errors.New("some error"),
+			Expected: struct {
+				Code    codes.Code
+				Message string
+			}{
+				Code:    codes.Unknown,
+				Message: "some error",
+			},
+		},
+		{
+			Name:  "code is mapped",
+			Error: gocerr.New(404, "not found"),
+			Expected: struct {
+				Code    codes.Code
+				Message string
+			}{
+				Code:    codes.NotFound,
+				Message: "not found",
+			},
+		},
+		{
+			Name:  "unprocessable entity",
+			Error: gocerr.New(422, "unprocessable entity", gocerr.NewErrorField("email", "invalid format")),
+			Expected: struct {
+				Code    codes.Code
+				Message string
+			}{
+				Code:    codes.InvalidArgument,
+				Message: "unprocessable entity",
+			},
+		},
+		{
+			Name:  "bad gateway",
+			Error: gocerr.New(502, "bad gateway"),
+			Expected: struct {
+				Code    codes.Code
+				Message string
+			}{
+				Code:    codes.Unavailable,
+				Message: "bad gateway",
+			},
+		},
+		{
+			Name:  "code is not mapped",
+			Error: gocerr.New(418, "i'm a teapot"),
+			Expected: struct {
+				Code    codes.Code
+				Message string
+			}{
+				Code:    codes.Unknown,
+				Message: "i'm a teapot",
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual *status.Status = ToStatus(testCases[i].Error)
+
+			if testCases[i].Expected.Code != actual.Code() {
+				t.Errorf("expected code is %v, but got %v", testCases[i].Expected.Code, actual.Code())
+			}
+
+			if testCases[i].Expected.Message != actual.Message() {
+				t.Errorf("expected message is %s, but got %s", testCases[i].Expected.Message, actual.Message())
+			}
+		})
+	}
+}
+
+func TestFromStatus(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Status   *status.Status
+		Expected gocerr.Error
+	}{
+		{
+			Name:     "status is nil",
+			Status:   nil,
+			Expected: gocerr.Error{},
+		},
+		{
+			Name: "round trip with error fields",
+			Status: ToStatus(gocerr.New(
+				400,
+				"bad request",
+				gocerr.NewErrorField("field1", "field is required"),
+				gocerr.NewErrorFieldWithCode("field2", "min", "min value is 50"),
+			)),
+			Expected: gocerr.New(
+				400,
+				"bad request",
+				gocerr.NewErrorField("field1", "field is required"),
+				gocerr.NewErrorFieldWithCode("field2", "min", "min value is 50"),
+			),
+		},
+		{
+			Name: "round trip of unprocessable entity with error fields",
+			Status: ToStatus(gocerr.New(
+				422,
+				"unprocessable entity",
+				gocerr.NewErrorField("email", "invalid format"),
+			)),
+			Expected: gocerr.New(
+				422,
+				"unprocessable entity",
+				gocerr.NewErrorField("email", "invalid format"),
+			),
+		},
+		{
+			Name:     "round trip of codes sharing a grpc code",
+			Status:   ToStatus(gocerr.New(503, "service unavailable")),
+			Expected: gocerr.New(503, "service unavailable"),
+		},
+		{
+			Name:     "round trip of unmapped code",
+			Status:   ToStatus(gocerr.New(418, "i'm a teapot")),
+			Expected: gocerr.New(418, "i'm a teapot"),
+		},
+		{
+			Name:     "grpc code shared by several codes",
+			Status:   status.New(codes.InvalidArgument, "invalid argument"),
+			Expected: gocerr.New(400, "invalid argument"),
+		},
+		{
+			Name:     "grpc code is not mapped",
+			Status:   status.New(codes.DataLoss, "data loss"),
+			Expected: gocerr.New(500, "data loss"),
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual gocerr.Error = FromStatus(testCases[i].Status)
+
+			if !testCases[i].Expected.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Expected.String(), actual.String())
+			}
+		})
+	}
+}
+
+func TestFromStatus_OnNew(t *testing.T) {
+	var count int
+
+	gocerr.OnNew = func(gocerr.Error) {
+		count++
+	}
+	defer func() {
+		gocerr.OnNew = nil
+	}()
+
+	_ = FromStatus(status.New(codes.InvalidArgument, "invalid argument"))
+
+	if count != 0 {
+		t.Errorf("expected hook calls is %d, but got %d", 0, count)
+	}
+}
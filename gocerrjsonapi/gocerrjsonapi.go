@@ -0,0 +1,84 @@
+package gocerrjsonapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fikri240794/gocerr"
+)
+
+var ErrNoErrors error = errors.New("gocerrjsonapi: document has no errors")
+
+type document struct {
+	Errors []errorObject `json:"errors"`
+}
+
+type errorObject struct {
+	Status string `json:"status"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+	Source struct {
+		Pointer   string `json:"pointer"`
+		Parameter string `json:"parameter"`
+	} `json:"source"`
+}
+
+// FromErrorsArray parses a JSON:API document of the form
+// {"errors":[{"status":...,"detail":...,"source":{"pointer":...}}]} into a
+// gocerr.Error. Each error object becomes an error field named after its
+// source pointer (or source parameter) with its detail (or title) as message.
+// The code is the highest status among the error objects and the message is
+// its HTTP status text.
+func FromErrorsArray(data []byte) (gocerr.Error, error) {
+	var (
+		doc         document
+		err         error
+		code        int
+		status      int
+		field       string
+		message     string
+		errorFields []gocerr.ErrorField
+	)
+
+	err = json.Unmarshal(data, &doc)
+	if err != nil {
+		return gocerr.Error{}, err
+	}
+
+	if len(doc.Errors) == 0 {
+		return gocerr.Error{}, ErrNoErrors
+	}
+
+	for i := 0; i < len(doc.Errors); i++ {
+		if doc.Errors[i].Status != "" {
+			status, err = strconv.Atoi(doc.Errors[i].Status)
+			if err != nil {
+				return gocerr.Error{}, fmt.Errorf("gocerrjsonapi: invalid status %q: %w", doc.Errors[i].Status, err)
+			}
+			if status > code {
+				code = status
+			}
+		}
+
+		field = doc.Errors[i].Source.Pointer
+		if field == "" {
+			field = doc.Errors[i].Source.Parameter
+		}
+
+		message = doc.Errors[i].Detail
+		if message == "" {
+			message = doc.Errors[i].Title
+		}
+
+		errorFields = append(errorFields, gocerr.NewErrorField(field, message))
+	}
+
+	return gocerr.Error{
+		Code:        code,
+		Message:     http.StatusText(code),
+		ErrorFields: errorFields,
+	}, nil
+}
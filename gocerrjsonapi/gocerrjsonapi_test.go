@@ -0,0 +1,114 @@
+package gocerrjsonapi
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fikri240794/gocerr"
+)
+
+func TestFromErrorsArray(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Data     string
+		Expected struct {
+			CustomError gocerr.Error
+			HasError    bool
+		}
+	}{
+		{
+			Name: "json api error document",
+			Data: `{
+				"errors": [
+					{
+						"status": "422",
+						"title": "Invalid Attribute",
+						"detail": "First name must contain at least two characters.",
+						"source": {"pointer": "/data/attributes/firstName"}
+					},
+					{
+						"status": "400",
+						"title": "Invalid Query Parameter",
+						"source": {"parameter": "sort"}
+					}
+				]
+			}`,
+			Expected: struct {
+				CustomError gocerr.Error
+				HasError    bool
+			}{
+				CustomError: gocerr.New(
+					422,
+					"Unprocessable Entity",
+					gocerr.NewErrorField("/data/attributes/firstName", "First name must contain at least two characters."),
+					gocerr.NewErrorField("sort", "Invalid Query Parameter"),
+				),
+			},
+		},
+		{
+			Name: "no errors",
+			Data: `{"errors": []}`,
+			Expected: struct {
+				CustomError gocerr.Error
+				HasError    bool
+			}{
+				HasError: true,
+			},
+		},
+		{
+			Name: "invalid status",
+			Data: `{"errors": [{"status": "bad"}]}`,
+			Expected: struct {
+				CustomError gocerr.Error
+				HasError    bool
+			}{
+				HasError: true,
+			},
+		},
+		{
+			Name: "invalid json",
+			Data: `{"errors":`,
+			Expected: struct {
+				CustomError gocerr.Error
+				HasError    bool
+			}{
+				HasError: true,
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			actual, err := FromErrorsArray([]byte(testCases[i].Data))
+
+			if testCases[i].Expected.HasError != (err != nil) {
+				t.Fatalf("expected has error is %t, but got %v", testCases[i].Expected.HasError, err)
+			}
+
+			if !testCases[i].Expected.CustomError.EqualIgnoring(actual) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Expected.CustomError.String(), actual.String())
+			}
+		})
+	}
+
+	if _, err := FromErrorsArray([]byte(`{}`)); !errors.Is(err, ErrNoErrors) {
+		t.Errorf("expected error is %v, but got %v", ErrNoErrors, err)
+	}
+}
+
+func TestFromErrorsArray_OnNew(t *testing.T) {
+	var count int
+
+	gocerr.OnNew = func(gocerr.Error) {
+		count++
+	}
+	defer func() {
+		gocerr.OnNew = nil
+	}()
+
+	_, _ = FromErrorsArray([]byte(`{"errors":[{"status":"422","detail":"is required","source":{"pointer":"/data/name"}}]}`))
+
+	if count != 0 {
+		t.Errorf("expected hook calls is %d, but got %d", 0, count)
+	}
+}
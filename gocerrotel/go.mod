@@ -0,0 +1,10 @@
+module github.com/fikri240794/gocerr/gocerrotel
+
+go 1.25.0
+
+require (
+	github.com/fikri240794/gocerr v0.0.0-20261016011506-ac4f84ed02d4
+	go.opentelemetry.io/otel v1.44.0
+)
+
+require github.com/cespare/xxhash/v2 v2.3.0 // indirect
@@ -0,0 +1,39 @@
+package gocerrotel
+
+import (
+	"strconv"
+
+	"github.com/fikri240794/gocerr"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Attributes returns err as OpenTelemetry attributes for span.SetAttributes:
+// error.code, error.message and error.field_count, plus one
+// error.field.<n> attribute per error field (0-indexed) holding
+// "field: message". It returns nil when err is not a custom error.
+func Attributes(err error) []attribute.KeyValue {
+	var (
+		customError   gocerr.Error
+		isCustomError bool
+		attributes    []attribute.KeyValue
+	)
+
+	customError, isCustomError = gocerr.Parse(err)
+	if !isCustomError {
+		return nil
+	}
+
+	attributes = make([]attribute.KeyValue, 0, 3+len(customError.ErrorFields))
+	attributes = append(
+		attributes,
+		attribute.Int("error.code", customError.Code),
+		attribute.String("error.message", customError.Message),
+		attribute.Int("error.field_count", len(customError.ErrorFields)),
+	)
+
+	for i := 0; i < len(customError.ErrorFields); i++ {
+		attributes = append(attributes, attribute.String("error.field."+strconv.Itoa(i), customError.ErrorFields[i].String()))
+	}
+
+	return attributes
+}
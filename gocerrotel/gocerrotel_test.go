@@ -0,0 +1,59 @@
+package gocerrotel
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fikri240794/gocerr"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestAttributes(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected []attribute.KeyValue
+	}{
+		{
+			Name:     "error is not custom error",
+			Error:    errors.New("some error"),
+			Expected: nil,
+		},
+		{
+			Name: "error with error fields",
+			Error: gocerr.New(
+				400,
+				"bad request",
+				gocerr.NewErrorField("field1", "field is required"),
+				gocerr.NewErrorField("field2", "min value is 50"),
+			),
+			Expected: []attribute.KeyValue{
+				attribute.Int("error.code", 400),
+				attribute.String("error.message", "bad request"),
+				attribute.Int("error.field_count", 2),
+				attribute.String("error.field.0", "field1: field is required"),
+				attribute.String("error.field.1", "field2: min value is 50"),
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []attribute.KeyValue = Attributes(testCases[i].Error)
+
+			if testCases[i].Expected == nil && actual != nil {
+				t.Fatalf("expected attributes is nil, but got %v", actual)
+			}
+
+			if len(testCases[i].Expected) != len(actual) {
+				t.Fatalf("expected length of attributes is %d, but got %d", len(testCases[i].Expected), len(actual))
+			}
+
+			for j := 0; j < len(testCases[i].Expected); j++ {
+				if testCases[i].Expected[j] != actual[j] {
+					t.Errorf("expected attribute is %s=%s, but got %s=%s", testCases[i].Expected[j].Key, testCases[i].Expected[j].Value.Emit(), actual[j].Key, actual[j].Value.Emit())
+				}
+			}
+		})
+	}
+}
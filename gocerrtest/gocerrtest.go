@@ -0,0 +1,71 @@
+package gocerrtest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fikri240794/gocerr"
+)
+
+// Spec describes the expected code and field to message pairs of an error
+// for declarative table-driven tests.
+type Spec struct {
+	Code   int
+	Fields map[string]string
+}
+
+// Matches reports whether err is a custom error with the spec code and
+// exactly the spec fields (in any order). When it does not match, the
+// returned string describes every difference, one per line.
+func (s Spec) Matches(err error) (bool, string) {
+	var (
+		customError   gocerr.Error
+		isCustomError bool
+		diffs         []string
+		actualFields  map[string][]string
+		names         []string
+	)
+
+	customError, isCustomError = gocerr.Parse(err)
+	if !isCustomError {
+		return false, fmt.Sprintf("expected custom error, but got %v", err)
+	}
+
+	if s.Code != customError.Code {
+		diffs = append(diffs, fmt.Sprintf("code: expected %d, but got %d", s.Code, customError.Code))
+	}
+
+	actualFields = make(map[string][]string, len(customError.ErrorFields))
+	for i := 0; i < len(customError.ErrorFields); i++ {
+		actualFields[customError.ErrorFields[i].Field] = append(actualFields[customError.ErrorFields[i].Field], customError.ErrorFields[i].Message)
+	}
+
+	for name := range s.Fields {
+		names = append(names, name)
+	}
+	for name := range actualFields {
+		if _, expected := s.Fields[name]; !expected {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for i := 0; i < len(names); i++ {
+		expectedMessage, expected := s.Fields[names[i]]
+		actualMessages := actualFields[names[i]]
+
+		switch {
+		case !expected:
+			diffs = append(diffs, fmt.Sprintf("field %s: unexpected with message %q", names[i], strings.Join(actualMessages, ", ")))
+		case len(actualMessages) == 0:
+			diffs = append(diffs, fmt.Sprintf("field %s: missing, expected message %q", names[i], expectedMessage))
+		case len(actualMessages) > 1:
+			diffs = append(diffs, fmt.Sprintf("field %s: expected once, but got %d times", names[i], len(actualMessages)))
+		case actualMessages[0] != expectedMessage:
+			diffs = append(diffs, fmt.Sprintf("field %s: expected message %q, but got %q", names[i], expectedMessage, actualMessages[0]))
+		}
+	}
+
+	return len(diffs) == 0, strings.Join(diffs, "\n")
+}
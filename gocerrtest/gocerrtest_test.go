@@ -0,0 +1,125 @@
+package gocerrtest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fikri240794/gocerr"
+)
+
+func TestSpec_Matches(t *testing.T) {
+	var customError gocerr.Error = gocerr.New(
+		400,
+		"bad request",
+		gocerr.NewErrorField("email", "email is invalid"),
+		gocerr.NewErrorField("age", "min value is 18"),
+	)
+
+	testCases := []struct {
+		Name     string
+		Spec     Spec
+		Error    error
+		Expected struct {
+			Match bool
+			Diff  string
+		}
+	}{
+		{
+			Name: "matching spec",
+			Spec: Spec{
+				Code: 400,
+				Fields: map[string]string{
+					"age":   "min value is 18",
+					"email": "email is invalid",
+				},
+			},
+			Error: customError,
+			Expected: struct {
+				Match bool
+				Diff  string
+			}{
+				Match: true,
+			},
+		},
+		{
+			Name:  "error is not custom error",
+			Spec:  Spec{Code: 400},
+			Error: errors.New("some error"),
+			Expected: struct {
+				Match bool
+				Diff  string
+			}{
+				Diff: "expected custom error, but got some error",
+			},
+		},
+		{
+			Name: "code mismatch",
+			Spec: Spec{
+				Code: 422,
+				Fields: map[string]string{
+					"age":   "min value is 18",
+					"email": "email is invalid",
+				},
+			},
+			Error: customError,
+			Expected: struct {
+				Match bool
+				Diff  string
+			}{
+				Diff: "code: expected 422, but got 400",
+			},
+		},
+		{
+			Name: "missing, unexpected and mismatched fields",
+			Spec: Spec{
+				Code: 400,
+				Fields: map[string]string{
+					"email": "email is required",
+					"name":  "field is required",
+				},
+			},
+			Error: customError,
+			Expected: struct {
+				Match bool
+				Diff  string
+			}{
+				Diff: "field age: unexpected with message \"min value is 18\"\n" +
+					"field email: expected message \"email is required\", but got \"email is invalid\"\n" +
+					"field name: missing, expected message \"field is required\"",
+			},
+		},
+		{
+			Name: "repeated field",
+			Spec: Spec{
+				Code:   400,
+				Fields: map[string]string{"email": "email is invalid"},
+			},
+			Error: gocerr.New(
+				400,
+				"bad request",
+				gocerr.NewErrorField("email", "email is invalid"),
+				gocerr.NewErrorField("email", "email is invalid"),
+			),
+			Expected: struct {
+				Match bool
+				Diff  string
+			}{
+				Diff: "field email: expected once, but got 2 times",
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			actualMatch, actualDiff := testCases[i].Spec.Matches(testCases[i].Error)
+
+			if testCases[i].Expected.Match != actualMatch {
+				t.Errorf("expected match is %t, but got %t", testCases[i].Expected.Match, actualMatch)
+			}
+
+			if testCases[i].Expected.Diff != actualDiff {
+				t.Errorf("expected diff is %q, but got %q", testCases[i].Expected.Diff, actualDiff)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package gocerr
+
+// Validator collects the failures of a validation session. Each Check whose
+// condition does not hold records an error field; Err then reports them as a
+// single Error, or nil when every check passed.
+type Validator struct {
+	builder   *Builder
+	hasErrors bool
+}
+
+func NewValidator(code int, message string) *Validator {
+	return &Validator{
+		builder: NewBuilder(code, message),
+	}
+}
+
+// Check records an error field with the given field and message when cond is
+// false, that is, cond is the condition a valid input satisfies.
+func (v *Validator) Check(cond bool, field string, message string) *Validator {
+	if !cond {
+		v.builder.AddField(field, message)
+		v.hasErrors = true
+	}
+	return v
+}
+
+func (v *Validator) HasErrors() bool {
+	return v.hasErrors
+}
+
+func (v *Validator) Err() error {
+	if !v.hasErrors {
+		return nil
+	}
+
+	return v.builder.Build()
+}
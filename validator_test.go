@@ -0,0 +1,75 @@
+package gocerr
+
+import "testing"
+
+func TestValidator_Err(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Age      int
+		Email    string
+		Expected struct {
+			HasErrors bool
+			Error     error
+		}
+	}{
+		{
+			Name:  "no check fails",
+			Age:   20,
+			Email: "john@example.com",
+			Expected: struct {
+				HasErrors bool
+				Error     error
+			}{
+				HasErrors: false,
+				Error:     nil,
+			},
+		},
+		{
+			Name:  "several checks fail",
+			Age:   16,
+			Email: "",
+			Expected: struct {
+				HasErrors bool
+				Error     error
+			}{
+				HasErrors: true,
+				Error: New(
+					422,
+					"unprocessable entity",
+					NewErrorField("age", "min value is 18"),
+					NewErrorField("email", "field is required"),
+				),
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				validator *Validator = NewValidator(422, "unprocessable entity")
+				actual    error
+			)
+
+			validator.
+				Check(testCases[i].Age >= 18, "age", "min value is 18").
+				Check(testCases[i].Email != "", "email", "field is required")
+
+			if testCases[i].Expected.HasErrors != validator.HasErrors() {
+				t.Errorf("expected has errors is %t, but got %t", testCases[i].Expected.HasErrors, validator.HasErrors())
+			}
+
+			actual = validator.Err()
+
+			if testCases[i].Expected.Error == nil {
+				if actual != nil {
+					t.Errorf("expected error is nil, but got %v", actual)
+				}
+				return
+			}
+
+			if !testCases[i].Expected.Error.(Error).EqualIgnoring(actual.(Error)) {
+				t.Errorf("expected error is %s, but got %s", testCases[i].Expected.Error.(Error).String(), actual.(Error).String())
+			}
+		})
+	}
+}